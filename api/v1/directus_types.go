@@ -20,6 +20,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -67,6 +69,156 @@ type DirectusRedis struct {
 	EnableInstallation bool `json:"enableInstallation,omitempty"`
 }
 
+// DirectusCache defines data cache configuration
+type DirectusCache struct {
+	// Enabled determines if the data cache should be used
+	Enabled bool `json:"enabled,omitempty"`
+	// TTL is how long cached data is kept (e.g. 5m, 1h)
+	TTL string `json:"ttl,omitempty"`
+	// AutoPurge determines if the cache is purged when data changes
+	AutoPurge bool `json:"autoPurge,omitempty"`
+	// Namespace is the key prefix used in the cache store
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// DirectusRateLimiter defines API rate limiter configuration
+type DirectusRateLimiter struct {
+	// Enabled determines if the rate limiter should be used
+	Enabled bool `json:"enabled,omitempty"`
+	// Points is the number of requests allowed per duration
+	Points int32 `json:"points,omitempty"`
+	// Duration is the window in seconds in which points are counted
+	Duration int32 `json:"duration,omitempty"`
+}
+
+// DirectusBackupStorage defines where backups are stored
+type DirectusBackupStorage struct {
+	// PersistentVolumeClaim is the name of a claim backups are written to
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
+	// S3 defines an S3-compatible bucket backups are uploaded to
+	S3 *DirectusBackupS3 `json:"s3,omitempty"`
+}
+
+// DirectusBackupS3 defines an S3-compatible backup target
+type DirectusBackupS3 struct {
+	// Bucket is the bucket name
+	Bucket string `json:"bucket"`
+	// Prefix is the key prefix backups are stored under
+	Prefix string `json:"prefix,omitempty"`
+	// Endpoint is the S3 API endpoint for non-AWS providers (e.g. MinIO)
+	Endpoint string `json:"endpoint,omitempty"`
+	// Region is the bucket region
+	Region string `json:"region,omitempty"`
+	// ExistingSecret refers to a secret with the keys "accessKeyId" and "secretAccessKey"
+	ExistingSecret string `json:"existingSecret,omitempty"`
+}
+
+// DirectusBackupRetention defines how long backups are kept
+type DirectusBackupRetention struct {
+	// KeepLast is the number of most recent backups to keep
+	KeepLast int32 `json:"keepLast,omitempty"`
+	// MaxAgeDays deletes backups older than this many days
+	MaxAgeDays int32 `json:"maxAgeDays,omitempty"`
+}
+
+// DirectusBackupSchedule defines scheduled backups of the database and uploads
+type DirectusBackupSchedule struct {
+	// Enabled determines if the backup CronJob should be created
+	Enabled bool `json:"enabled,omitempty"`
+	// Schedule is the cron schedule of the backups
+	Schedule string `json:"schedule,omitempty"`
+	// Suspend pauses scheduled backups without removing the CronJob
+	Suspend bool `json:"suspend,omitempty"`
+	// Image overrides the image providing pg_dump or mysqldump
+	Image string `json:"image,omitempty"`
+	// UploadsClaimName is the claim holding uploaded files, archived with each backup when set
+	UploadsClaimName string `json:"uploadsClaimName,omitempty"`
+	// Storage defines where backups are stored
+	Storage DirectusBackupStorage `json:"storage,omitempty"`
+	// Retention defines how long backups are kept
+	Retention DirectusBackupRetention `json:"retention,omitempty"`
+	// Resources defines the resource requirements of the backup containers
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// DirectusSecretRotation defines scheduled rotation of KEY and SECRET
+type DirectusSecretRotation struct {
+	// Interval between rotations, e.g. 720h. Only on-demand rotations run when unset.
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// MaintenanceWindow restricts when rotations run, scheduled or on demand
+	MaintenanceWindow *DirectusMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+// DirectusMaintenanceWindow defines a recurring time window in UTC
+type DirectusMaintenanceWindow struct {
+	// Days the window opens on, every day when empty
+	// +kubebuilder:validation:items:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+	Days []string `json:"days,omitempty"`
+	// Start is the time of day the window opens, HH:MM in UTC
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+	// Duration of the window
+	Duration metav1.Duration `json:"duration"`
+}
+
+// DirectusSecretsFrom defines an external store the application credentials are read from
+type DirectusSecretsFrom struct {
+	// ExternalSecret creates an External Secrets Operator ExternalSecret producing the application secret
+	ExternalSecret *DirectusExternalSecret `json:"externalSecret,omitempty"`
+	// Vault injects the credentials as files with the Vault Agent injector
+	Vault *DirectusVaultSecrets `json:"vault,omitempty"`
+}
+
+// DirectusExternalSecret defines an ExternalSecret whose target is the application secret
+type DirectusExternalSecret struct {
+	// SecretStoreRef references the SecretStore or ClusterSecretStore to read from
+	SecretStoreRef DirectusSecretStoreRef `json:"secretStoreRef"`
+	// RefreshInterval is how often the values are read from the store (defaults to 1h)
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+	// Data maps application secret keys, e.g. ADMIN_PASSWORD, to values in the store
+	Data []DirectusExternalSecretData `json:"data,omitempty"`
+	// DataFrom lists store keys whose properties are all added to the application secret
+	DataFrom []string `json:"dataFrom,omitempty"`
+	// Template is passed to spec.target.template of the ExternalSecret
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Template *runtime.RawExtension `json:"template,omitempty"`
+}
+
+// DirectusSecretStoreRef references an External Secrets Operator store
+type DirectusSecretStoreRef struct {
+	// Name of the store
+	Name string `json:"name"`
+	// Kind of the store
+	// +kubebuilder:validation:Enum=SecretStore;ClusterSecretStore
+	// +kubebuilder:default=SecretStore
+	Kind string `json:"kind,omitempty"`
+}
+
+// DirectusExternalSecretData maps one application secret key to a value in the store
+type DirectusExternalSecretData struct {
+	// SecretKey is the key in the application secret
+	SecretKey string `json:"secretKey"`
+	// RemoteKey is the key in the store
+	RemoteKey string `json:"remoteKey"`
+	// Property selects a property of the remote value
+	Property string `json:"property,omitempty"`
+}
+
+// DirectusVaultSecrets defines credentials injected by the Vault Agent injector
+type DirectusVaultSecrets struct {
+	// Role is the Vault Kubernetes auth role of the pods
+	Role string `json:"role"`
+	// Path of the secret in Vault, e.g. secret/data/directus
+	Path string `json:"path"`
+	// Keys are read from the secret and passed to Directus as <KEY>_FILE (defaults to ADMIN_PASSWORD, KEY and SECRET)
+	Keys []string `json:"keys,omitempty"`
+	// KVVersion is the version of the key/value secrets engine
+	// +kubebuilder:validation:Enum=1;2
+	// +kubebuilder:default=2
+	KVVersion int32 `json:"kvVersion,omitempty"`
+}
+
 // DirectusIngress defines ingress configuration
 type DirectusIngress struct {
 	// Enabled determines if ingress should be created
@@ -81,6 +233,100 @@ type DirectusIngress struct {
 	Hosts []DirectusIngressHost `json:"hosts,omitempty"`
 	// TLS defines the TLS configuration
 	TLS []networkingv1.IngressTLS `json:"tls,omitempty"`
+	// Certificate creates a cert-manager Certificate for all hosts and
+	// configures the ingress TLS with it, replacing tls
+	Certificate *DirectusCertificate `json:"certificate,omitempty"`
+}
+
+// DirectusRoute defines an OpenShift Route exposing Directus
+type DirectusRoute struct {
+	// Enabled determines if the Route should be created
+	Enabled bool `json:"enabled,omitempty"`
+	// Host of the Route (generated by OpenShift when empty)
+	Host string `json:"host,omitempty"`
+	// Path the Route matches
+	Path string `json:"path,omitempty"`
+	// Annotations contains Route annotations
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// TLS defines the TLS termination of the Route
+	TLS *DirectusRouteTLS `json:"tls,omitempty"`
+}
+
+// DirectusRouteTLS defines the TLS termination of an OpenShift Route
+type DirectusRouteTLS struct {
+	// Termination is where TLS is terminated
+	// +kubebuilder:validation:Enum=edge;reencrypt;passthrough
+	// +kubebuilder:default=edge
+	// +optional
+	Termination string `json:"termination,omitempty"`
+	// InsecureEdgeTerminationPolicy defines the handling of plain HTTP requests
+	// +kubebuilder:validation:Enum=Allow;Redirect;None
+	InsecureEdgeTerminationPolicy string `json:"insecureEdgeTerminationPolicy,omitempty"`
+	// ExternalCertificateSecret names a TLS secret with the certificate served
+	// by the router (defaults to the router certificate)
+	ExternalCertificateSecret string `json:"externalCertificateSecret,omitempty"`
+	// DestinationCACertificate is the PEM CA certificate validating the pods for reencrypt termination
+	DestinationCACertificate string `json:"destinationCACertificate,omitempty"`
+}
+
+// DirectusCertificate defines a cert-manager Certificate for the ingress hosts
+type DirectusCertificate struct {
+	// IssuerRef references the cert-manager issuer signing the certificate
+	IssuerRef DirectusIssuerRef `json:"issuerRef"`
+	// SecretName of the TLS secret (defaults to <name>-tls)
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// DirectusIssuerRef references a cert-manager issuer
+type DirectusIssuerRef struct {
+	// Name of the issuer
+	Name string `json:"name"`
+	// Kind of the issuer
+	// +kubebuilder:default=Issuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Group of the issuer (defaults to cert-manager.io)
+	Group string `json:"group,omitempty"`
+}
+
+// DirectusGateway defines a Gateway API HTTPRoute routing to Directus
+// +kubebuilder:validation:XValidation:rule="!self.enabled || (has(self.parentRefs) && size(self.parentRefs) > 0)",message="parentRefs are required when the gateway route is enabled"
+type DirectusGateway struct {
+	// Enabled determines if the HTTPRoute should be created
+	Enabled bool `json:"enabled,omitempty"`
+	// EnableTLS determines if TLS should be enabled in PUBLIC_URL
+	EnableTLS bool `json:"enableTLS,omitempty"`
+	// Annotations contains HTTPRoute annotations
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ParentRefs are the Gateways the route attaches to
+	ParentRefs []DirectusGatewayParentRef `json:"parentRefs,omitempty"`
+	// Hostnames are the hostnames the route matches
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Paths are the paths the route matches (defaults to all paths)
+	Paths []DirectusGatewayPath `json:"paths,omitempty"`
+	// Filters are Gateway API HTTPRouteFilters applied to matching requests
+	Filters []runtime.RawExtension `json:"filters,omitempty"`
+}
+
+// DirectusGatewayParentRef references a Gateway
+type DirectusGatewayParentRef struct {
+	// Name of the Gateway
+	Name string `json:"name"`
+	// Namespace of the Gateway (defaults to the namespace of the Directus resource)
+	Namespace string `json:"namespace,omitempty"`
+	// SectionName selects a listener of the Gateway
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// DirectusGatewayPath defines a path match of the HTTPRoute
+type DirectusGatewayPath struct {
+	// Type of the match
+	// +kubebuilder:validation:Enum=Exact;PathPrefix;RegularExpression
+	// +kubebuilder:default=PathPrefix
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Value is the path to match
+	Value string `json:"value"`
 }
 
 // DirectusIngressHost defines ingress host configuration
@@ -113,6 +359,88 @@ type DirectusAutoscaling struct {
 	TargetMemoryUtilizationPercentage int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
 }
 
+// DirectusPodDisruptionBudget defines the PodDisruptionBudget of the Directus pods.
+// At most one of MinAvailable and MaxUnavailable may be set, MaxUnavailable
+// defaults to 1 when neither is.
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="minAvailable and maxUnavailable are mutually exclusive"
+type DirectusPodDisruptionBudget struct {
+	// Enabled creates the PodDisruptionBudget. By default it is created when
+	// replicaCount is greater than 1 or autoscaling is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+	// MinAvailable is the number or percentage of pods that must stay available during voluntary disruptions
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of pods that may be unavailable during voluntary disruptions
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// DirectusOverride patches every generated object of a kind before it is written
+type DirectusOverride struct {
+	// Kind of the generated objects to patch
+	// +kubebuilder:validation:Enum=Deployment;Service;Ingress;HorizontalPodAutoscaler;ConfigMap;ServiceAccount
+	Kind string `json:"kind"`
+	// Type of the patch, StrategicMerge or JSON (RFC 6902)
+	// +kubebuilder:validation:Enum=StrategicMerge;JSON
+	// +kubebuilder:default=StrategicMerge
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Patch is a strategic merge patch object or a list of JSON patch operations
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Patch runtime.RawExtension `json:"patch"`
+}
+
+// DirectusMetrics defines the Prometheus metrics exposed by Directus on /metrics
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceMonitor) || !self.serviceMonitor.enabled || self.enabled",message="serviceMonitor requires metrics to be enabled"
+type DirectusMetrics struct {
+	// Enabled exposes the metrics (METRICS_ENABLED)
+	Enabled bool `json:"enabled,omitempty"`
+	// Services lists the services metrics are collected for, e.g. database,
+	// cache, redis and storage (METRICS_SERVICES)
+	Services []string `json:"services,omitempty"`
+	// Schedule is the cron schedule metrics are collected on (METRICS_SCHEDULE)
+	Schedule string `json:"schedule,omitempty"`
+	// TokenSecretRef selects the token scrapers authenticate with (METRICS_TOKENS).
+	// Without a token only admins may read the metrics.
+	TokenSecretRef *corev1.SecretKeySelector `json:"tokenSecretRef,omitempty"`
+	// ServiceMonitor creates a Prometheus Operator ServiceMonitor scraping the metrics
+	ServiceMonitor *DirectusServiceMonitor `json:"serviceMonitor,omitempty"`
+}
+
+// DirectusServiceMonitor defines the ServiceMonitor scraping Directus
+type DirectusServiceMonitor struct {
+	// Enabled creates the ServiceMonitor
+	Enabled bool `json:"enabled,omitempty"`
+	// Labels are added to the ServiceMonitor, e.g. to match the serviceMonitorSelector of Prometheus
+	Labels map[string]string `json:"labels,omitempty"`
+	// Interval between scrapes, e.g. 30s
+	// +kubebuilder:validation:Pattern=`^(0|(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$`
+	Interval string `json:"interval,omitempty"`
+	// ScrapeTimeout of a scrape, e.g. 10s
+	// +kubebuilder:validation:Pattern=`^(0|(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$`
+	ScrapeTimeout string `json:"scrapeTimeout,omitempty"`
+}
+
+// DirectusNetworkPolicy defines the NetworkPolicy restricting the traffic of the Directus pods.
+// Egress to the database and Redis is derived from their hosts.
+type DirectusNetworkPolicy struct {
+	// Enabled creates the NetworkPolicy
+	Enabled bool `json:"enabled,omitempty"`
+	// IngressControllerNamespace may reach Directus when ingress is enabled (defaults to ingress-nginx)
+	IngressControllerNamespace string `json:"ingressControllerNamespace,omitempty"`
+	// GatewayNamespaces run the Gateway data plane and may reach Directus when the gateway is
+	// enabled (defaults to the namespaces of the parent Gateways)
+	GatewayNamespaces []string `json:"gatewayNamespaces,omitempty"`
+	// MonitoringNamespace runs Prometheus and may scrape Directus when metrics are enabled
+	// (defaults to monitoring)
+	MonitoringNamespace string `json:"monitoringNamespace,omitempty"`
+	// AllowedNamespaces lists further namespaces that may reach Directus
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+	// AllowedPodSelectors select pods in the same namespace that may reach Directus
+	AllowedPodSelectors []metav1.LabelSelector `json:"allowedPodSelectors,omitempty"`
+	// EgressCIDRs Directus may connect to on any port, e.g. object storage and SMTP servers
+	EgressCIDRs []string `json:"egressCIDRs,omitempty"`
+}
+
 // DirectusProbe defines probe configuration
 type DirectusProbe struct {
 	// Enabled determines if the probe should be enabled
@@ -132,11 +460,86 @@ type DirectusServiceAccount struct {
 }
 
 // DirectusService defines service configuration
+// +kubebuilder:validation:XValidation:rule="!has(self.nodePort) || (has(self.type) && self.type in ['NodePort', 'LoadBalancer'])",message="nodePort requires a NodePort or LoadBalancer service"
+// +kubebuilder:validation:XValidation:rule="!has(self.externalTrafficPolicy) || (has(self.type) && self.type in ['NodePort', 'LoadBalancer'])",message="externalTrafficPolicy requires a NodePort or LoadBalancer service"
+// +kubebuilder:validation:XValidation:rule="!has(self.metricsPort) || self.metricsPort != (has(self.port) ? self.port : 80)",message="metricsPort must differ from port"
 type DirectusService struct {
 	// Type defines the service type
 	Type corev1.ServiceType `json:"type,omitempty"`
 	// Port defines the service port
 	Port int32 `json:"port,omitempty"`
+	// Annotations defines service annotations, e.g. for cloud load balancer controllers
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// NodePort fixes the node port for NodePort and LoadBalancer services
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	NodePort int32 `json:"nodePort,omitempty"`
+	// LoadBalancerSourceRanges restricts the client IPs of LoadBalancer services
+	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
+	// ExternalTrafficPolicy defines how external traffic is routed for NodePort and LoadBalancer services
+	// +kubebuilder:validation:Enum=Cluster;Local
+	ExternalTrafficPolicy corev1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty"`
+	// SessionAffinity defines the session affinity (ClientIP or None)
+	// +kubebuilder:validation:Enum=ClientIP;None
+	SessionAffinity corev1.ServiceAffinity `json:"sessionAffinity,omitempty"`
+	// MetricsPort adds a "metrics" service port targeting the Directus port, which serves /metrics.
+	// Defaults to 9090 when metrics are enabled.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	MetricsPort int32 `json:"metricsPort,omitempty"`
+}
+
+// DirectusEmail defines email transport configuration
+type DirectusEmail struct {
+	// Transport defines the email transport (smtp, mailgun, sendgrid or ses)
+	// +kubebuilder:validation:Enum=smtp;mailgun;sendgrid;ses
+	Transport string `json:"transport,omitempty"`
+	// From is the sender address used for outgoing emails
+	From string `json:"from,omitempty"`
+	// VerifySetup determines if Directus should verify the transport on startup
+	VerifySetup *bool `json:"verifySetup,omitempty"`
+	// SMTP defines the SMTP transport settings
+	SMTP DirectusEmailSMTP `json:"smtp,omitempty"`
+	// Mailgun defines the Mailgun transport settings
+	Mailgun DirectusEmailMailgun `json:"mailgun,omitempty"`
+	// SES defines the Amazon SES transport settings
+	SES DirectusEmailSES `json:"ses,omitempty"`
+	// ExistingSecret refers to an existing secret with the transport credentials.
+	// The keys "password" (smtp), "apiKey" (mailgun, sendgrid), "accessKeyId" and
+	// "secretAccessKey" (ses) are read from it.
+	ExistingSecret string `json:"existingSecret,omitempty"`
+}
+
+// DirectusEmailSMTP defines SMTP transport configuration
+type DirectusEmailSMTP struct {
+	// Host is the SMTP server hostname
+	Host string `json:"host,omitempty"`
+	// Port is the SMTP server port
+	Port int32 `json:"port,omitempty"`
+	// User is the SMTP username
+	User string `json:"user,omitempty"`
+	// Secure determines if the connection should use TLS
+	Secure bool `json:"secure,omitempty"`
+	// IgnoreTLS determines if STARTTLS should be skipped
+	IgnoreTLS bool `json:"ignoreTLS,omitempty"`
+	// Pool determines if pooled connections should be used
+	Pool bool `json:"pool,omitempty"`
+	// Name is the hostname used to identify the client to the server
+	Name string `json:"name,omitempty"`
+}
+
+// DirectusEmailMailgun defines Mailgun transport configuration
+type DirectusEmailMailgun struct {
+	// Domain is the Mailgun sending domain
+	Domain string `json:"domain,omitempty"`
+	// Host is the Mailgun API host (e.g. api.eu.mailgun.net)
+	Host string `json:"host,omitempty"`
+}
+
+// DirectusEmailSES defines Amazon SES transport configuration
+type DirectusEmailSES struct {
+	// Region is the AWS region of the SES endpoint
+	Region string `json:"region,omitempty"`
 }
 
 // DirectusSpec defines the desired state of Directus.
@@ -159,6 +562,15 @@ type DirectusSpec struct {
 	// PodAnnotations defines annotations to add to the pod
 	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
 
+	// CommonLabels are added to every object the operator creates, including pods
+	CommonLabels map[string]string `json:"commonLabels,omitempty"`
+
+	// CommonAnnotations are added to every object the operator creates, including pods
+	CommonAnnotations map[string]string `json:"commonAnnotations,omitempty"`
+
+	// PropagateLabels lists labels of the Directus resource copied to every object the operator creates
+	PropagateLabels []string `json:"propagateLabels,omitempty"`
+
 	// PodSecurityContext defines the security context for the pod
 	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
 
@@ -171,6 +583,12 @@ type DirectusSpec struct {
 	// Ingress defines the ingress configuration
 	Ingress DirectusIngress `json:"ingress,omitempty"`
 
+	// Gateway defines a Gateway API HTTPRoute, an alternative to the ingress
+	Gateway *DirectusGateway `json:"gateway,omitempty"`
+
+	// Route defines an OpenShift Route, an alternative to the ingress
+	Route *DirectusRoute `json:"route,omitempty"`
+
 	// ExtraEnvVars defines additional environment variables
 	ExtraEnvVars []corev1.EnvVar `json:"extraEnvVars,omitempty"`
 
@@ -183,6 +601,15 @@ type DirectusSpec struct {
 	// Autoscaling defines the HPA configuration
 	Autoscaling DirectusAutoscaling `json:"autoscaling,omitempty"`
 
+	// Metrics defines the Prometheus metrics of Directus
+	Metrics *DirectusMetrics `json:"metrics,omitempty"`
+
+	// NetworkPolicy defines the NetworkPolicy restricting ingress and egress of the pods
+	NetworkPolicy *DirectusNetworkPolicy `json:"networkPolicy,omitempty"`
+
+	// PodDisruptionBudget defines the PodDisruptionBudget protecting the pods from node drains
+	PodDisruptionBudget *DirectusPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+
 	// EnableLivenessProbe determines if liveness probe should be enabled
 	EnableLivenessProbe bool `json:"enableLivenessProbe,omitempty"`
 
@@ -201,6 +628,18 @@ type DirectusSpec struct {
 	// Affinity defines pod affinity rules
 	Affinity *corev1.Affinity `json:"affinity,omitempty"`
 
+	// PodTemplate is a strategic merge patch applied to the generated pod
+	// template, e.g. to set topologySpreadConstraints, priorityClassName,
+	// runtimeClassName, terminationGracePeriodSeconds, dnsConfig or hostAliases
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	// +optional
+	PodTemplate *runtime.RawExtension `json:"podTemplate,omitempty"`
+
+	// Overrides patch the generated objects before they are written
+	// +optional
+	Overrides []DirectusOverride `json:"overrides,omitempty"`
+
 	// ExtraVolumes defines additional volumes
 	ExtraVolumes []corev1.Volume `json:"extraVolumes,omitempty"`
 
@@ -213,12 +652,30 @@ type DirectusSpec struct {
 	// ApplicationSecretName defines the name of the application secret
 	ApplicationSecretName string `json:"applicationSecretName,omitempty"`
 
+	// SecretsFrom reads the application credentials from an external store instead of a generated Secret
+	SecretsFrom *DirectusSecretsFrom `json:"secretsFrom,omitempty"`
+
+	// SecretRotation defines scheduled rotation of KEY and SECRET in the application secret
+	SecretRotation *DirectusSecretRotation `json:"secretRotation,omitempty"`
+
 	// Database defines the database configuration
 	Database DirectusDatabase `json:"database,omitempty"`
 
 	// Redis defines the Redis configuration
 	Redis DirectusRedis `json:"redis,omitempty"`
 
+	// Cache defines the data cache configuration
+	Cache DirectusCache `json:"cache,omitempty"`
+
+	// RateLimiter defines the API rate limiter configuration
+	RateLimiter DirectusRateLimiter `json:"rateLimiter,omitempty"`
+
+	// Email defines the email transport configuration
+	Email *DirectusEmail `json:"email,omitempty"`
+
+	// Backup defines scheduled database and uploads backups
+	Backup *DirectusBackupSchedule `json:"backup,omitempty"`
+
 	// InitContainers defines init containers
 	InitContainers []corev1.Container `json:"initContainers,omitempty"`
 
@@ -226,9 +683,91 @@ type DirectusSpec struct {
 	Sidecars []corev1.Container `json:"sidecars,omitempty"`
 }
 
+// DirectusRouteStatus defines the observed state of the OpenShift Route
+type DirectusRouteStatus struct {
+	// Hosts lists the hosts admitted by at least one router
+	Hosts []string `json:"hosts,omitempty"`
+}
+
+// DirectusCertificateStatus defines the observed state of the ingress certificate
+type DirectusCertificateStatus struct {
+	// NotAfter is the expiry time of the issued certificate
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+	// RenewalTime is when cert-manager will renew the certificate
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+}
+
+// DirectusIngressStatus defines the observed state of the ingress
+type DirectusIngressStatus struct {
+	// Addresses lists the IPs and hostnames the ingress controller admitted the Ingress on
+	Addresses []string `json:"addresses,omitempty"`
+	// Hosts lists the hostnames routed to Directus
+	Hosts []string `json:"hosts,omitempty"`
+}
+
+// DirectusBackupResult describes a completed backup
+type DirectusBackupResult struct {
+	// CompletionTime is when the backup finished
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// SizeBytes is the total size of the backup files
+	SizeBytes int64 `json:"sizeBytes,omitempty"`
+	// Location is where the database dump was stored
+	Location string `json:"location,omitempty"`
+}
+
+// RotateAdminPasswordAnnotation on a Directus generates a new admin password,
+// applies it to the instance and writes it to the application secret. The
+// operator removes the annotation once the password is rotated.
+const RotateAdminPasswordAnnotation = "directus.example.com/rotate-admin-password"
+
+// RotateSecretsAnnotation on a Directus rotates KEY and SECRET in the next
+// maintenance window, or right away without one. The operator removes the
+// annotation once the secrets are rotated.
+const RotateSecretsAnnotation = "directus.example.com/rotate-secrets"
+
+// SecretsRotatedAtAnnotation on the pod template records the last rotation of
+// KEY and SECRET, changing it restarts the pods with the new values. On the
+// application secret it records the rotation the Secret holds.
+const SecretsRotatedAtAnnotation = "directus.example.com/secrets-rotated-at"
+
+// Condition types reported in DirectusStatus.Conditions
+const (
+	// ConditionAvailable indicates that at least one replica is serving
+	ConditionAvailable = "Available"
+	// ConditionProgressing indicates that a rollout is in progress
+	ConditionProgressing = "Progressing"
+	// ConditionDegraded indicates that the instance failed to reach the desired state
+	ConditionDegraded = "Degraded"
+	// ConditionDatabaseReady indicates that Directus is connected to its database
+	ConditionDatabaseReady = "DatabaseReady"
+	// ConditionRedisReady indicates that Directus is connected to Redis
+	ConditionRedisReady = "RedisReady"
+	// ConditionEmailReady indicates that Directus reports its email transport as healthy
+	ConditionEmailReady = "EmailReady"
+	// ConditionIngressReady indicates that the ingress is serving traffic
+	ConditionIngressReady = "IngressReady"
+	// ConditionCertificateReady indicates that cert-manager issued the ingress certificate
+	ConditionCertificateReady = "CertificateReady"
+	// ConditionRouteAdmitted indicates that the OpenShift routers admitted the Route
+	ConditionRouteAdmitted = "RouteAdmitted"
+	// ConditionRouteAccepted indicates that the Gateways accepted the HTTPRoute
+	ConditionRouteAccepted = "RouteAccepted"
+	// ConditionSecretsReady indicates that the credentials from spec.secretsFrom are available
+	ConditionSecretsReady = "SecretsReady"
+	// ConditionSpecWarnings indicates that the spec is accepted but likely misconfigured
+	ConditionSpecWarnings = "SpecWarnings"
+	// ConditionReconciled indicates that the last reconcile applied the spec successfully
+	ConditionReconciled = "Reconciled"
+)
+
 // DirectusStatus defines the observed state of Directus.
 type DirectusStatus struct {
+	// ObservedGeneration is the most recent generation observed by the operator
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// Conditions represent the latest available observations of the Directus state
+	// +listType=map
+	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
 	// ReadyReplicas indicates how many replicas are ready
@@ -251,6 +790,24 @@ type DirectusStatus struct {
 
 	// IngressReady indicates if the ingress is ready
 	IngressReady bool `json:"ingressReady,omitempty"`
+
+	// Ingress reports the load balancer addresses and hosts of the ingress
+	Ingress *DirectusIngressStatus `json:"ingress,omitempty"`
+
+	// Route reports the hosts the OpenShift routers admitted the Route on
+	Route *DirectusRouteStatus `json:"route,omitempty"`
+
+	// Certificate reports the validity of the ingress certificate
+	Certificate *DirectusCertificateStatus `json:"certificate,omitempty"`
+
+	// EmailReady indicates if Directus reports the email transport as healthy
+	EmailReady bool `json:"emailReady,omitempty"`
+
+	// LastBackup describes the last successful scheduled backup
+	LastBackup *DirectusBackupResult `json:"lastBackup,omitempty"`
+
+	// SecretRotationTime is when KEY and SECRET were last rotated
+	SecretRotationTime *metav1.Time `json:"secretRotationTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -258,6 +815,7 @@ type DirectusStatus struct {
 // +kubebuilder:subresource:scale:specpath=.spec.replicaCount,statuspath=.status.replicas,selectorpath=.status.selector
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.readyReplicas"
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Available')].status"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Directus is the Schema for the directuses API.
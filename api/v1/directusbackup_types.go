@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Phases reported in DirectusBackupStatus.Phase and DirectusRestoreStatus.Phase
+const (
+	// PhasePending waits for the referenced resources
+	PhasePending = "Pending"
+	// PhaseRunning indicates that the backup Job is running
+	PhaseRunning = "Running"
+	// PhaseScalingDown waits for the Directus pods to terminate
+	PhaseScalingDown = "ScalingDown"
+	// PhaseRestoring indicates that the restore Job is running
+	PhaseRestoring = "Restoring"
+	// PhaseMigrating indicates that the database migrations are running
+	PhaseMigrating = "Migrating"
+	// PhaseScalingUp waits for the Directus pods to become ready
+	PhaseScalingUp = "ScalingUp"
+	// PhaseSucceeded indicates that the operation completed
+	PhaseSucceeded = "Succeeded"
+	// PhaseFailed indicates that the operation failed, it is not retried
+	PhaseFailed = "Failed"
+)
+
+// DirectusBackupSpec defines the desired state of DirectusBackup.
+type DirectusBackupSpec struct {
+	// DirectusRef references the Directus instance in the same namespace to back up
+	DirectusRef corev1.LocalObjectReference `json:"directusRef"`
+	// Image is the database client image running the dump (defaults to postgres or mysql)
+	Image string `json:"image,omitempty"`
+	// UploadsClaimName is a claim holding the uploads folder to archive alongside the dump
+	UploadsClaimName string `json:"uploadsClaimName,omitempty"`
+	// Storage defines where the backup is stored
+	Storage DirectusBackupStorage `json:"storage"`
+	// Resources defines the resource requirements of the backup containers
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// DirectusBackupStatus defines the observed state of DirectusBackup.
+type DirectusBackupStatus struct {
+	// Phase is one of Pending, Running, Succeeded or Failed
+	Phase string `json:"phase,omitempty"`
+	// Message explains the current phase
+	Message string `json:"message,omitempty"`
+	// JobName is the name of the Job running the backup
+	JobName string `json:"jobName,omitempty"`
+	// StartTime is when the backup Job was created
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Result of the backup once it succeeded
+	DirectusBackupResult `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Directus",type="string",JSONPath=".spec.directusRef.name"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Location",type="string",JSONPath=".status.location",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DirectusBackup is the Schema for the directusbackups API.
+type DirectusBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DirectusBackupSpec   `json:"spec,omitempty"`
+	Status DirectusBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectusBackupList contains a list of DirectusBackup.
+type DirectusBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DirectusBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DirectusBackup{}, &DirectusBackupList{})
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Drift policies of DirectusFlowSpec.DriftPolicy
+const (
+	// DriftPolicyRevert reverts changes made in the admin app
+	DriftPolicyRevert = "Revert"
+	// DriftPolicyReport only reports changes made in the admin app
+	DriftPolicyReport = "Report"
+)
+
+// DirectusFlowOperation defines an operation of a flow
+type DirectusFlowOperation struct {
+	// Key identifies the operation within the flow
+	// +kubebuilder:validation:Pattern=`^[a-z0-9_-]+$`
+	Key string `json:"key"`
+	// Name shown in the admin app (defaults to the key)
+	Name string `json:"name,omitempty"`
+	// Type of the operation, e.g. log, request, item-create or exec
+	Type string `json:"type"`
+	// Options of the operation
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Options *runtime.RawExtension `json:"options,omitempty"`
+	// Resolve is the key of the operation run when this one succeeds
+	Resolve string `json:"resolve,omitempty"`
+	// Reject is the key of the operation run when this one fails
+	Reject string `json:"reject,omitempty"`
+	// PositionX is the grid column in the flow editor
+	PositionX int32 `json:"positionX,omitempty"`
+	// PositionY is the grid row in the flow editor
+	PositionY int32 `json:"positionY,omitempty"`
+}
+
+// DirectusFlowSpec defines the desired state of DirectusFlow.
+type DirectusFlowSpec struct {
+	// DirectusRef references the Directus instance in the same namespace the flow is created in
+	DirectusRef corev1.LocalObjectReference `json:"directusRef"`
+	// Name of the flow in Directus (defaults to the resource name)
+	Name string `json:"name,omitempty"`
+	// Icon is the Material icon shown in the admin app
+	Icon string `json:"icon,omitempty"`
+	// Color shown in the admin app
+	Color string `json:"color,omitempty"`
+	// Description of the flow
+	Description string `json:"description,omitempty"`
+	// Active enables the flow (defaults to true)
+	Active *bool `json:"active,omitempty"`
+	// Trigger starting the flow
+	// +kubebuilder:validation:Enum=event;schedule;webhook;operation;manual
+	Trigger string `json:"trigger"`
+	// Accountability tracked for runs of the flow (defaults to all)
+	// +kubebuilder:validation:Enum=all;activity;none
+	Accountability string `json:"accountability,omitempty"`
+	// Options of the trigger, e.g. the event scope and collections or the cron schedule
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Options *runtime.RawExtension `json:"options,omitempty"`
+	// Operation is the key of the first operation
+	Operation string `json:"operation,omitempty"`
+	// Operations of the flow, operations not listed here are removed
+	Operations []DirectusFlowOperation `json:"operations,omitempty"`
+	// DriftPolicy defines what happens to changes made in the admin app: Revert or Report
+	// +kubebuilder:validation:Enum=Revert;Report
+	// +kubebuilder:default=Revert
+	DriftPolicy string `json:"driftPolicy,omitempty"`
+}
+
+// DirectusFlowStatus defines the observed state of DirectusFlow.
+type DirectusFlowStatus struct {
+	// ObservedGeneration is the generation of the spec last applied
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// ID is the Directus ID of the flow, derived from the resource namespace and name
+	ID string `json:"id,omitempty"`
+	// Drift lists the changes made in the admin app that were not reverted
+	Drift []string `json:"drift,omitempty"`
+	// Conditions represent the latest available observations of the flow
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Directus",type="string",JSONPath=".spec.directusRef.name"
+// +kubebuilder:printcolumn:name="Trigger",type="string",JSONPath=".spec.trigger"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DirectusFlow is the Schema for the directusflows API.
+type DirectusFlow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DirectusFlowSpec   `json:"spec,omitempty"`
+	Status DirectusFlowStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectusFlowList contains a list of DirectusFlow.
+type DirectusFlowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DirectusFlow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DirectusFlow{}, &DirectusFlowList{})
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DirectusPermission grants an action on a collection
+type DirectusPermission struct {
+	// Collection the permission applies to
+	Collection string `json:"collection"`
+	// Action that is allowed
+	// +kubebuilder:validation:Enum=create;read;update;delete;share
+	Action string `json:"action"`
+	// Fields that may be accessed (defaults to all fields)
+	Fields []string `json:"fields,omitempty"`
+	// Permissions is a Directus filter items must match
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Permissions *runtime.RawExtension `json:"permissions,omitempty"`
+	// Validation is a Directus filter submitted data must match
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Validation *runtime.RawExtension `json:"validation,omitempty"`
+	// Presets are default field values for created or updated items
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Presets *runtime.RawExtension `json:"presets,omitempty"`
+}
+
+// DirectusPolicySpec defines the desired state of DirectusPolicy.
+type DirectusPolicySpec struct {
+	// DirectusRef references the Directus instance in the same namespace the policy is created in
+	DirectusRef corev1.LocalObjectReference `json:"directusRef"`
+	// Name of the policy in Directus (defaults to the resource name)
+	Name string `json:"name,omitempty"`
+	// Adopt takes over a policy of the same name that already exists in Directus,
+	// without it the sync fails instead. Adopted policies are not deleted with
+	// the resource and keep the permissions not listed in the resource.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+	// Icon is the Material icon shown in the admin app
+	Icon string `json:"icon,omitempty"`
+	// Description of the policy
+	Description string `json:"description,omitempty"`
+	// AdminAccess grants full access to everything
+	AdminAccess bool `json:"adminAccess,omitempty"`
+	// AppAccess allows signing in to the admin app
+	AppAccess bool `json:"appAccess,omitempty"`
+	// EnforceTFA requires two-factor authentication
+	EnforceTFA bool `json:"enforceTFA,omitempty"`
+	// IPAccess restricts access to these IP addresses or ranges
+	IPAccess []string `json:"ipAccess,omitempty"`
+	// Permissions granted by the policy, permissions not listed here are removed
+	Permissions []DirectusPermission `json:"permissions,omitempty"`
+}
+
+// DirectusPolicyStatus defines the observed state of DirectusPolicy.
+type DirectusPolicyStatus struct {
+	// ObservedGeneration is the generation of the spec last synced
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// ID is the Directus ID of the policy
+	ID string `json:"id,omitempty"`
+	// Created is true when the operator created the policy, only created policies
+	// are deleted with the resource
+	Created bool `json:"created,omitempty"`
+	// Conditions represent the latest available observations of the policy
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Directus",type="string",JSONPath=".spec.directusRef.name"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DirectusPolicy is the Schema for the directuspolicies API.
+type DirectusPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DirectusPolicySpec   `json:"spec,omitempty"`
+	Status DirectusPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectusPolicyList contains a list of DirectusPolicy.
+type DirectusPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DirectusPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DirectusPolicy{}, &DirectusPolicyList{})
+}
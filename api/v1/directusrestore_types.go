@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RestoreInProgressAnnotation is set on a Directus instance while a
+// DirectusRestore runs, the operator keeps its Deployment scaled to zero
+const RestoreInProgressAnnotation = "directus.example.com/restore-in-progress"
+
+// DirectusRestoreSpec defines the desired state of DirectusRestore.
+type DirectusRestoreSpec struct {
+	// DirectusRef references the Directus instance in the same namespace to restore
+	DirectusRef corev1.LocalObjectReference `json:"directusRef"`
+	// BackupRef references a succeeded DirectusBackup to restore
+	BackupRef *corev1.LocalObjectReference `json:"backupRef,omitempty"`
+	// Location of the database dump to restore when no backupRef is set,
+	// as reported by a backup (pvc://<claim>/<path> or s3://<bucket>/<key>)
+	Location string `json:"location,omitempty"`
+	// Storage provides the S3 endpoint and credentials for an s3:// location,
+	// taken from the referenced backup when backupRef is set
+	Storage DirectusBackupStorage `json:"storage,omitempty"`
+	// Image is the database client image running the restore (defaults to postgres or mysql)
+	Image string `json:"image,omitempty"`
+	// UploadsClaimName is a claim the uploads archive of the backup is extracted to, if present
+	UploadsClaimName string `json:"uploadsClaimName,omitempty"`
+	// Resources defines the resource requirements of the restore containers
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// DirectusRestoreStatus defines the observed state of DirectusRestore.
+type DirectusRestoreStatus struct {
+	// Phase is one of Pending, ScalingDown, Restoring, Migrating, ScalingUp, Succeeded or Failed
+	Phase string `json:"phase,omitempty"`
+	// Message explains the current phase
+	Message string `json:"message,omitempty"`
+	// Location is the database dump being restored
+	Location string `json:"location,omitempty"`
+	// StartTime is when the restore started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is when the restore finished
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Directus",type="string",JSONPath=".spec.directusRef.name"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DirectusRestore is the Schema for the directusrestores API.
+type DirectusRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DirectusRestoreSpec   `json:"spec,omitempty"`
+	Status DirectusRestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectusRestoreList contains a list of DirectusRestore.
+type DirectusRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DirectusRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DirectusRestore{}, &DirectusRestoreList{})
+}
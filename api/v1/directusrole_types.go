@@ -0,0 +1,86 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DirectusRoleSpec defines the desired state of DirectusRole.
+type DirectusRoleSpec struct {
+	// DirectusRef references the Directus instance in the same namespace the role is created in
+	DirectusRef corev1.LocalObjectReference `json:"directusRef"`
+	// Name of the role in Directus (defaults to the resource name)
+	Name string `json:"name,omitempty"`
+	// Adopt takes over a role of the same name that already exists in Directus,
+	// without it the sync fails instead. Adopted roles are not deleted with
+	// the resource and keep the policies attached to them outside the resource.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+	// Icon is the Material icon shown in the admin app
+	Icon string `json:"icon,omitempty"`
+	// Description of the role
+	Description string `json:"description,omitempty"`
+	// PolicyRefs references the DirectusPolicy resources attached to the role,
+	// policies attached otherwise are detached
+	PolicyRefs []corev1.LocalObjectReference `json:"policyRefs,omitempty"`
+}
+
+// DirectusRoleStatus defines the observed state of DirectusRole.
+type DirectusRoleStatus struct {
+	// ObservedGeneration is the generation of the spec last synced
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// ID is the Directus ID of the role
+	ID string `json:"id,omitempty"`
+	// Created is true when the operator created the role, only created roles
+	// are deleted with the resource
+	Created bool `json:"created,omitempty"`
+	// Conditions represent the latest available observations of the role
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Directus",type="string",JSONPath=".spec.directusRef.name"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DirectusRole is the Schema for the directusroles API.
+type DirectusRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DirectusRoleSpec   `json:"spec,omitempty"`
+	Status DirectusRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectusRoleList contains a list of DirectusRole.
+type DirectusRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DirectusRole `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DirectusRole{}, &DirectusRoleList{})
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConditionSynced is reported by resources managed through the Directus API
+// and is true when the instance matches the resource
+const ConditionSynced = "Synced"
+
+// DirectusSchemaSpec defines the desired state of DirectusSchema.
+type DirectusSchemaSpec struct {
+	// DirectusRef references the Directus instance in the same namespace the schema is applied to
+	DirectusRef corev1.LocalObjectReference `json:"directusRef"`
+	// Snapshot is an inline schema snapshot as written by `directus schema snapshot`
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Snapshot *runtime.RawExtension `json:"snapshot,omitempty"`
+	// SnapshotFrom reads the snapshot, JSON or YAML, from a ConfigMap key instead
+	// +optional
+	SnapshotFrom *corev1.ConfigMapKeySelector `json:"snapshotFrom,omitempty"`
+	// DryRun only reports the differences without applying them
+	DryRun bool `json:"dryRun,omitempty"`
+	// Force applies snapshots taken from another Directus version or database vendor
+	Force bool `json:"force,omitempty"`
+	// Interval between drift checks (defaults to 5m)
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// DirectusSchemaStatus defines the observed state of DirectusSchema.
+type DirectusSchemaStatus struct {
+	// ObservedGeneration is the generation of the spec last compared with the instance
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// InSync is true when the instance schema matches the snapshot
+	InSync bool `json:"inSync,omitempty"`
+	// Changes lists the differences found by the last check, e.g. "create field articles.title"
+	Changes []string `json:"changes,omitempty"`
+	// LastCheckTime is when the schema was last compared with the instance
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+	// LastAppliedTime is when differences were last applied
+	LastAppliedTime *metav1.Time `json:"lastAppliedTime,omitempty"`
+	// Conditions represent the latest available observations of the schema
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Directus",type="string",JSONPath=".spec.directusRef.name"
+// +kubebuilder:printcolumn:name="Dry Run",type="boolean",JSONPath=".spec.dryRun"
+// +kubebuilder:printcolumn:name="In Sync",type="boolean",JSONPath=".status.inSync"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DirectusSchema is the Schema for the directusschemas API.
+type DirectusSchema struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DirectusSchemaSpec   `json:"spec,omitempty"`
+	Status DirectusSchemaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectusSchemaList contains a list of DirectusSchema.
+type DirectusSchemaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DirectusSchema `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DirectusSchema{}, &DirectusSchemaList{})
+}
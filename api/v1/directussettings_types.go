@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DirectusTranslation defines a custom translation string
+type DirectusTranslation struct {
+	// Key used to reference the string, e.g. in field labels as $t:key
+	Key string `json:"key"`
+	// Language code, e.g. en-US
+	Language string `json:"language"`
+	// Value is the translated string
+	Value string `json:"value"`
+}
+
+// DirectusSettingsSpec defines the desired state of DirectusSettings.
+// Settings that are not set are left as configured in the admin app.
+type DirectusSettingsSpec struct {
+	// DirectusRef references the Directus instance in the same namespace the settings are applied to
+	DirectusRef corev1.LocalObjectReference `json:"directusRef"`
+	// ProjectName is shown in the admin app and on the login page
+	ProjectName string `json:"projectName,omitempty"`
+	// ProjectDescriptor is shown below the project name
+	ProjectDescriptor string `json:"projectDescriptor,omitempty"`
+	// ProjectURL is the public URL of the project, linked from the admin app
+	ProjectURL string `json:"projectURL,omitempty"`
+	// ProjectColor is the brand color as a hex value
+	// +kubebuilder:validation:Pattern=`^#[0-9A-Fa-f]{6}$`
+	ProjectColor string `json:"projectColor,omitempty"`
+	// LogoURL is the URL of an image imported as the project logo
+	LogoURL string `json:"logoURL,omitempty"`
+	// DefaultLanguage of the admin app, e.g. en-US
+	DefaultLanguage string `json:"defaultLanguage,omitempty"`
+	// PublicRegistration allows users to register on the login page
+	PublicRegistration *bool `json:"publicRegistration,omitempty"`
+	// PublicRegistrationRoleRef references the DirectusRole given to registered users
+	PublicRegistrationRoleRef *corev1.LocalObjectReference `json:"publicRegistrationRoleRef,omitempty"`
+	// AuthLoginAttempts is the number of failed logins before a user is suspended, 0 disables the limit
+	// +kubebuilder:validation:Minimum=0
+	AuthLoginAttempts *int32 `json:"authLoginAttempts,omitempty"`
+	// Translations are custom translation strings
+	Translations []DirectusTranslation `json:"translations,omitempty"`
+}
+
+// DirectusSettingsStatus defines the observed state of DirectusSettings.
+type DirectusSettingsStatus struct {
+	// ObservedGeneration is the generation of the spec last synced
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LogoURL is the URL the current logo was imported from
+	LogoURL string `json:"logoURL,omitempty"`
+	// LogoFileID is the Directus file ID of the imported logo
+	LogoFileID string `json:"logoFileID,omitempty"`
+	// Conditions represent the latest available observations of the settings
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Directus",type="string",JSONPath=".spec.directusRef.name"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.projectName"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DirectusSettings is the Schema for the directussettings API.
+type DirectusSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DirectusSettingsSpec   `json:"spec,omitempty"`
+	Status DirectusSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectusSettingsList contains a list of DirectusSettings.
+type DirectusSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DirectusSettings `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DirectusSettings{}, &DirectusSettingsList{})
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DirectusUserToken defines the Secret a static API token is written to
+type DirectusUserToken struct {
+	// SecretName is the Secret the token is written to (defaults to <resource name>-token).
+	// Deleting the Secret rotates the token.
+	SecretName string `json:"secretName,omitempty"`
+	// Key is the Secret key holding the token (defaults to token)
+	Key string `json:"key,omitempty"`
+}
+
+// DirectusUserSpec defines the desired state of DirectusUser.
+type DirectusUserSpec struct {
+	// DirectusRef references the Directus instance in the same namespace the user is created in
+	DirectusRef corev1.LocalObjectReference `json:"directusRef"`
+	// Email identifies the user
+	// +kubebuilder:validation:MinLength=3
+	Email string `json:"email"`
+	// Adopt takes over a user with the same email that already exists in
+	// Directus, without it the sync fails instead. Adopted users are not deleted
+	// with the resource. The admin user of the instance cannot be adopted.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+	// FirstName of the user
+	FirstName string `json:"firstName,omitempty"`
+	// LastName of the user
+	LastName string `json:"lastName,omitempty"`
+	// RoleRef references the DirectusRole assigned to the user
+	RoleRef *corev1.LocalObjectReference `json:"roleRef,omitempty"`
+	// Suspended prevents the user from signing in or using its token
+	Suspended bool `json:"suspended,omitempty"`
+	// Token generates a static API token for the user and writes it to a Secret
+	Token *DirectusUserToken `json:"token,omitempty"`
+}
+
+// DirectusUserStatus defines the observed state of DirectusUser.
+type DirectusUserStatus struct {
+	// ObservedGeneration is the generation of the spec last synced
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// ID is the Directus ID of the user
+	ID string `json:"id,omitempty"`
+	// Created is true when the operator created the user, only created users
+	// are deleted with the resource
+	Created bool `json:"created,omitempty"`
+	// TokenSecretName is the Secret holding the static token
+	TokenSecretName string `json:"tokenSecretName,omitempty"`
+	// TokenRotationTime is when the static token was last generated
+	TokenRotationTime *metav1.Time `json:"tokenRotationTime,omitempty"`
+	// Conditions represent the latest available observations of the user
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Directus",type="string",JSONPath=".spec.directusRef.name"
+// +kubebuilder:printcolumn:name="Email",type="string",JSONPath=".spec.email"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id"
+// +kubebuilder:printcolumn:name="Synced",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DirectusUser is the Schema for the directususers API.
+type DirectusUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DirectusUserSpec   `json:"spec,omitempty"`
+	Status DirectusUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectusUserList contains a list of DirectusUser.
+type DirectusUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DirectusUser `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DirectusUser{}, &DirectusUserList{})
+}
@@ -24,7 +24,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -69,38 +70,1291 @@ func (in *DirectusAutoscaling) DeepCopy() *DirectusAutoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusBackup) DeepCopyInto(out *DirectusBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusBackup.
+func (in *DirectusBackup) DeepCopy() *DirectusBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusBackupList) DeepCopyInto(out *DirectusBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectusBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusBackupList.
+func (in *DirectusBackupList) DeepCopy() *DirectusBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusBackupResult) DeepCopyInto(out *DirectusBackupResult) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusBackupResult.
+func (in *DirectusBackupResult) DeepCopy() *DirectusBackupResult {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusBackupResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusBackupRetention) DeepCopyInto(out *DirectusBackupRetention) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusBackupRetention.
+func (in *DirectusBackupRetention) DeepCopy() *DirectusBackupRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusBackupRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusBackupS3) DeepCopyInto(out *DirectusBackupS3) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusBackupS3.
+func (in *DirectusBackupS3) DeepCopy() *DirectusBackupS3 {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusBackupS3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusBackupSchedule) DeepCopyInto(out *DirectusBackupSchedule) {
+	*out = *in
+	in.Storage.DeepCopyInto(&out.Storage)
+	out.Retention = in.Retention
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusBackupSchedule.
+func (in *DirectusBackupSchedule) DeepCopy() *DirectusBackupSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusBackupSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusBackupSpec) DeepCopyInto(out *DirectusBackupSpec) {
+	*out = *in
+	out.DirectusRef = in.DirectusRef
+	in.Storage.DeepCopyInto(&out.Storage)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusBackupSpec.
+func (in *DirectusBackupSpec) DeepCopy() *DirectusBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusBackupStatus) DeepCopyInto(out *DirectusBackupStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	in.DirectusBackupResult.DeepCopyInto(&out.DirectusBackupResult)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusBackupStatus.
+func (in *DirectusBackupStatus) DeepCopy() *DirectusBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusBackupStorage) DeepCopyInto(out *DirectusBackupStorage) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(DirectusBackupS3)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusBackupStorage.
+func (in *DirectusBackupStorage) DeepCopy() *DirectusBackupStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusBackupStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusCache) DeepCopyInto(out *DirectusCache) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusCache.
+func (in *DirectusCache) DeepCopy() *DirectusCache {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusCertificate) DeepCopyInto(out *DirectusCertificate) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusCertificate.
+func (in *DirectusCertificate) DeepCopy() *DirectusCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusCertificateStatus) DeepCopyInto(out *DirectusCertificateStatus) {
+	*out = *in
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalTime != nil {
+		in, out := &in.RenewalTime, &out.RenewalTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusCertificateStatus.
+func (in *DirectusCertificateStatus) DeepCopy() *DirectusCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DirectusDatabase) DeepCopyInto(out *DirectusDatabase) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusDatabase.
-func (in *DirectusDatabase) DeepCopy() *DirectusDatabase {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusDatabase.
+func (in *DirectusDatabase) DeepCopy() *DirectusDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusEmail) DeepCopyInto(out *DirectusEmail) {
+	*out = *in
+	if in.VerifySetup != nil {
+		in, out := &in.VerifySetup, &out.VerifySetup
+		*out = new(bool)
+		**out = **in
+	}
+	out.SMTP = in.SMTP
+	out.Mailgun = in.Mailgun
+	out.SES = in.SES
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusEmail.
+func (in *DirectusEmail) DeepCopy() *DirectusEmail {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusEmail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusEmailMailgun) DeepCopyInto(out *DirectusEmailMailgun) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusEmailMailgun.
+func (in *DirectusEmailMailgun) DeepCopy() *DirectusEmailMailgun {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusEmailMailgun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusEmailSES) DeepCopyInto(out *DirectusEmailSES) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusEmailSES.
+func (in *DirectusEmailSES) DeepCopy() *DirectusEmailSES {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusEmailSES)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusEmailSMTP) DeepCopyInto(out *DirectusEmailSMTP) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusEmailSMTP.
+func (in *DirectusEmailSMTP) DeepCopy() *DirectusEmailSMTP {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusEmailSMTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusExternalSecret) DeepCopyInto(out *DirectusExternalSecret) {
+	*out = *in
+	out.SecretStoreRef = in.SecretStoreRef
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]DirectusExternalSecretData, len(*in))
+		copy(*out, *in)
+	}
+	if in.DataFrom != nil {
+		in, out := &in.DataFrom, &out.DataFrom
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusExternalSecret.
+func (in *DirectusExternalSecret) DeepCopy() *DirectusExternalSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusExternalSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusExternalSecretData) DeepCopyInto(out *DirectusExternalSecretData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusExternalSecretData.
+func (in *DirectusExternalSecretData) DeepCopy() *DirectusExternalSecretData {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusExternalSecretData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusFlow) DeepCopyInto(out *DirectusFlow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusFlow.
+func (in *DirectusFlow) DeepCopy() *DirectusFlow {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusFlow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusFlow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusFlowList) DeepCopyInto(out *DirectusFlowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectusFlow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusFlowList.
+func (in *DirectusFlowList) DeepCopy() *DirectusFlowList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusFlowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusFlowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusFlowOperation) DeepCopyInto(out *DirectusFlowOperation) {
+	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusFlowOperation.
+func (in *DirectusFlowOperation) DeepCopy() *DirectusFlowOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusFlowOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusFlowSpec) DeepCopyInto(out *DirectusFlowSpec) {
+	*out = *in
+	out.DirectusRef = in.DirectusRef
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]DirectusFlowOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusFlowSpec.
+func (in *DirectusFlowSpec) DeepCopy() *DirectusFlowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusFlowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusFlowStatus) DeepCopyInto(out *DirectusFlowStatus) {
+	*out = *in
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusFlowStatus.
+func (in *DirectusFlowStatus) DeepCopy() *DirectusFlowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusFlowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusGateway) DeepCopyInto(out *DirectusGateway) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ParentRefs != nil {
+		in, out := &in.ParentRefs, &out.ParentRefs
+		*out = make([]DirectusGatewayParentRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]DirectusGatewayPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusGateway.
+func (in *DirectusGateway) DeepCopy() *DirectusGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusGatewayParentRef) DeepCopyInto(out *DirectusGatewayParentRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusGatewayParentRef.
+func (in *DirectusGatewayParentRef) DeepCopy() *DirectusGatewayParentRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusGatewayParentRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusGatewayPath) DeepCopyInto(out *DirectusGatewayPath) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusGatewayPath.
+func (in *DirectusGatewayPath) DeepCopy() *DirectusGatewayPath {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusGatewayPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusImage) DeepCopyInto(out *DirectusImage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusImage.
+func (in *DirectusImage) DeepCopy() *DirectusImage {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusIngress) DeepCopyInto(out *DirectusIngress) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]DirectusIngressHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = make([]networkingv1.IngressTLS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(DirectusCertificate)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusIngress.
+func (in *DirectusIngress) DeepCopy() *DirectusIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusIngressHost) DeepCopyInto(out *DirectusIngressHost) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]DirectusIngressPath, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusIngressHost.
+func (in *DirectusIngressHost) DeepCopy() *DirectusIngressHost {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusIngressHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusIngressPath) DeepCopyInto(out *DirectusIngressPath) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusIngressPath.
+func (in *DirectusIngressPath) DeepCopy() *DirectusIngressPath {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusIngressPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusIngressStatus) DeepCopyInto(out *DirectusIngressStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusIngressStatus.
+func (in *DirectusIngressStatus) DeepCopy() *DirectusIngressStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusIngressStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusIssuerRef) DeepCopyInto(out *DirectusIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusIssuerRef.
+func (in *DirectusIssuerRef) DeepCopy() *DirectusIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusList) DeepCopyInto(out *DirectusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Directus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusList.
+func (in *DirectusList) DeepCopy() *DirectusList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusMaintenanceWindow) DeepCopyInto(out *DirectusMaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusMaintenanceWindow.
+func (in *DirectusMaintenanceWindow) DeepCopy() *DirectusMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusMetrics) DeepCopyInto(out *DirectusMetrics) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceMonitor != nil {
+		in, out := &in.ServiceMonitor, &out.ServiceMonitor
+		*out = new(DirectusServiceMonitor)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusMetrics.
+func (in *DirectusMetrics) DeepCopy() *DirectusMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusNetworkPolicy) DeepCopyInto(out *DirectusNetworkPolicy) {
+	*out = *in
+	if in.GatewayNamespaces != nil {
+		in, out := &in.GatewayNamespaces, &out.GatewayNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedPodSelectors != nil {
+		in, out := &in.AllowedPodSelectors, &out.AllowedPodSelectors
+		*out = make([]metav1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EgressCIDRs != nil {
+		in, out := &in.EgressCIDRs, &out.EgressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusNetworkPolicy.
+func (in *DirectusNetworkPolicy) DeepCopy() *DirectusNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusOverride) DeepCopyInto(out *DirectusOverride) {
+	*out = *in
+	in.Patch.DeepCopyInto(&out.Patch)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusOverride.
+func (in *DirectusOverride) DeepCopy() *DirectusOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusPermission) DeepCopyInto(out *DirectusPermission) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Presets != nil {
+		in, out := &in.Presets, &out.Presets
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusPermission.
+func (in *DirectusPermission) DeepCopy() *DirectusPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusPodDisruptionBudget) DeepCopyInto(out *DirectusPodDisruptionBudget) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusPodDisruptionBudget.
+func (in *DirectusPodDisruptionBudget) DeepCopy() *DirectusPodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusPodDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusPolicy) DeepCopyInto(out *DirectusPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusPolicy.
+func (in *DirectusPolicy) DeepCopy() *DirectusPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusPolicyList) DeepCopyInto(out *DirectusPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectusPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusPolicyList.
+func (in *DirectusPolicyList) DeepCopy() *DirectusPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusPolicySpec) DeepCopyInto(out *DirectusPolicySpec) {
+	*out = *in
+	out.DirectusRef = in.DirectusRef
+	if in.IPAccess != nil {
+		in, out := &in.IPAccess, &out.IPAccess
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]DirectusPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusPolicySpec.
+func (in *DirectusPolicySpec) DeepCopy() *DirectusPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusPolicyStatus) DeepCopyInto(out *DirectusPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusPolicyStatus.
+func (in *DirectusPolicyStatus) DeepCopy() *DirectusPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusProbe) DeepCopyInto(out *DirectusProbe) {
+	*out = *in
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(corev1.HTTPGetAction)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusProbe.
+func (in *DirectusProbe) DeepCopy() *DirectusProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusRateLimiter) DeepCopyInto(out *DirectusRateLimiter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRateLimiter.
+func (in *DirectusRateLimiter) DeepCopy() *DirectusRateLimiter {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusRateLimiter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusRedis) DeepCopyInto(out *DirectusRedis) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRedis.
+func (in *DirectusRedis) DeepCopy() *DirectusRedis {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusRedis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusRestore) DeepCopyInto(out *DirectusRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRestore.
+func (in *DirectusRestore) DeepCopy() *DirectusRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusRestoreList) DeepCopyInto(out *DirectusRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectusRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRestoreList.
+func (in *DirectusRestoreList) DeepCopy() *DirectusRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusRestoreSpec) DeepCopyInto(out *DirectusRestoreSpec) {
+	*out = *in
+	out.DirectusRef = in.DirectusRef
+	if in.BackupRef != nil {
+		in, out := &in.BackupRef, &out.BackupRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	in.Storage.DeepCopyInto(&out.Storage)
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRestoreSpec.
+func (in *DirectusRestoreSpec) DeepCopy() *DirectusRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusRestoreStatus) DeepCopyInto(out *DirectusRestoreStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRestoreStatus.
+func (in *DirectusRestoreStatus) DeepCopy() *DirectusRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusRole) DeepCopyInto(out *DirectusRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRole.
+func (in *DirectusRole) DeepCopy() *DirectusRole {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusRoleList) DeepCopyInto(out *DirectusRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectusRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRoleList.
+func (in *DirectusRoleList) DeepCopy() *DirectusRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(DirectusDatabase)
+	out := new(DirectusRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusRoleSpec) DeepCopyInto(out *DirectusRoleSpec) {
+	*out = *in
+	out.DirectusRef = in.DirectusRef
+	if in.PolicyRefs != nil {
+		in, out := &in.PolicyRefs, &out.PolicyRefs
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRoleSpec.
+func (in *DirectusRoleSpec) DeepCopy() *DirectusRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DirectusImage) DeepCopyInto(out *DirectusImage) {
+func (in *DirectusRoleStatus) DeepCopyInto(out *DirectusRoleStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusImage.
-func (in *DirectusImage) DeepCopy() *DirectusImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRoleStatus.
+func (in *DirectusRoleStatus) DeepCopy() *DirectusRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DirectusImage)
+	out := new(DirectusRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DirectusIngress) DeepCopyInto(out *DirectusIngress) {
+func (in *DirectusRoute) DeepCopyInto(out *DirectusRoute) {
 	*out = *in
 	if in.Annotations != nil {
 		in, out := &in.Annotations, &out.Annotations
@@ -109,93 +1363,372 @@ func (in *DirectusIngress) DeepCopyInto(out *DirectusIngress) {
 			(*out)[key] = val
 		}
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(DirectusRouteTLS)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRoute.
+func (in *DirectusRoute) DeepCopy() *DirectusRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusRouteStatus) DeepCopyInto(out *DirectusRouteStatus) {
+	*out = *in
 	if in.Hosts != nil {
 		in, out := &in.Hosts, &out.Hosts
-		*out = make([]DirectusIngressHost, len(*in))
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRouteStatus.
+func (in *DirectusRouteStatus) DeepCopy() *DirectusRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusRouteTLS) DeepCopyInto(out *DirectusRouteTLS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRouteTLS.
+func (in *DirectusRouteTLS) DeepCopy() *DirectusRouteTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusRouteTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusSchema) DeepCopyInto(out *DirectusSchema) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusSchema.
+func (in *DirectusSchema) DeepCopy() *DirectusSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusSchema) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusSchemaList) DeepCopyInto(out *DirectusSchemaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectusSchema, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = make([]networkingv1.IngressTLS, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusSchemaList.
+func (in *DirectusSchemaList) DeepCopy() *DirectusSchemaList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusSchemaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusSchemaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusSchemaSpec) DeepCopyInto(out *DirectusSchemaSpec) {
+	*out = *in
+	out.DirectusRef = in.DirectusRef
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotFrom != nil {
+		in, out := &in.SnapshotFrom, &out.SnapshotFrom
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusSchemaSpec.
+func (in *DirectusSchemaSpec) DeepCopy() *DirectusSchemaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusSchemaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusSchemaStatus) DeepCopyInto(out *DirectusSchemaStatus) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastAppliedTime != nil {
+		in, out := &in.LastAppliedTime, &out.LastAppliedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusIngress.
-func (in *DirectusIngress) DeepCopy() *DirectusIngress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusSchemaStatus.
+func (in *DirectusSchemaStatus) DeepCopy() *DirectusSchemaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusSchemaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusSecretRotation) DeepCopyInto(out *DirectusSecretRotation) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(DirectusMaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusSecretRotation.
+func (in *DirectusSecretRotation) DeepCopy() *DirectusSecretRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusSecretRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusSecretStoreRef) DeepCopyInto(out *DirectusSecretStoreRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusSecretStoreRef.
+func (in *DirectusSecretStoreRef) DeepCopy() *DirectusSecretStoreRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusSecretStoreRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusSecretsFrom) DeepCopyInto(out *DirectusSecretsFrom) {
+	*out = *in
+	if in.ExternalSecret != nil {
+		in, out := &in.ExternalSecret, &out.ExternalSecret
+		*out = new(DirectusExternalSecret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(DirectusVaultSecrets)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusSecretsFrom.
+func (in *DirectusSecretsFrom) DeepCopy() *DirectusSecretsFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusSecretsFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusService) DeepCopyInto(out *DirectusService) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LoadBalancerSourceRanges != nil {
+		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusService.
+func (in *DirectusService) DeepCopy() *DirectusService {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusServiceAccount) DeepCopyInto(out *DirectusServiceAccount) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusServiceAccount.
+func (in *DirectusServiceAccount) DeepCopy() *DirectusServiceAccount {
 	if in == nil {
 		return nil
 	}
-	out := new(DirectusIngress)
+	out := new(DirectusServiceAccount)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DirectusIngressHost) DeepCopyInto(out *DirectusIngressHost) {
+func (in *DirectusServiceMonitor) DeepCopyInto(out *DirectusServiceMonitor) {
 	*out = *in
-	if in.Paths != nil {
-		in, out := &in.Paths, &out.Paths
-		*out = make([]DirectusIngressPath, len(*in))
-		copy(*out, *in)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusIngressHost.
-func (in *DirectusIngressHost) DeepCopy() *DirectusIngressHost {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusServiceMonitor.
+func (in *DirectusServiceMonitor) DeepCopy() *DirectusServiceMonitor {
 	if in == nil {
 		return nil
 	}
-	out := new(DirectusIngressHost)
+	out := new(DirectusServiceMonitor)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DirectusIngressPath) DeepCopyInto(out *DirectusIngressPath) {
+func (in *DirectusSettings) DeepCopyInto(out *DirectusSettings) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusIngressPath.
-func (in *DirectusIngressPath) DeepCopy() *DirectusIngressPath {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusSettings.
+func (in *DirectusSettings) DeepCopy() *DirectusSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(DirectusIngressPath)
+	out := new(DirectusSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusSettings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DirectusList) DeepCopyInto(out *DirectusList) {
+func (in *DirectusSettingsList) DeepCopyInto(out *DirectusSettingsList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Directus, len(*in))
+		*out = make([]DirectusSettings, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusList.
-func (in *DirectusList) DeepCopy() *DirectusList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusSettingsList.
+func (in *DirectusSettingsList) DeepCopy() *DirectusSettingsList {
 	if in == nil {
 		return nil
 	}
-	out := new(DirectusList)
+	out := new(DirectusSettingsList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DirectusList) DeepCopyObject() runtime.Object {
+func (in *DirectusSettingsList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -203,73 +1736,59 @@ func (in *DirectusList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DirectusProbe) DeepCopyInto(out *DirectusProbe) {
+func (in *DirectusSettingsSpec) DeepCopyInto(out *DirectusSettingsSpec) {
 	*out = *in
-	if in.HTTPGet != nil {
-		in, out := &in.HTTPGet, &out.HTTPGet
-		*out = new(corev1.HTTPGetAction)
-		(*in).DeepCopyInto(*out)
+	out.DirectusRef = in.DirectusRef
+	if in.PublicRegistration != nil {
+		in, out := &in.PublicRegistration, &out.PublicRegistration
+		*out = new(bool)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusProbe.
-func (in *DirectusProbe) DeepCopy() *DirectusProbe {
-	if in == nil {
-		return nil
+	if in.PublicRegistrationRoleRef != nil {
+		in, out := &in.PublicRegistrationRoleRef, &out.PublicRegistrationRoleRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
 	}
-	out := new(DirectusProbe)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DirectusRedis) DeepCopyInto(out *DirectusRedis) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusRedis.
-func (in *DirectusRedis) DeepCopy() *DirectusRedis {
-	if in == nil {
-		return nil
+	if in.AuthLoginAttempts != nil {
+		in, out := &in.AuthLoginAttempts, &out.AuthLoginAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Translations != nil {
+		in, out := &in.Translations, &out.Translations
+		*out = make([]DirectusTranslation, len(*in))
+		copy(*out, *in)
 	}
-	out := new(DirectusRedis)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DirectusService) DeepCopyInto(out *DirectusService) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusService.
-func (in *DirectusService) DeepCopy() *DirectusService {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusSettingsSpec.
+func (in *DirectusSettingsSpec) DeepCopy() *DirectusSettingsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DirectusService)
+	out := new(DirectusSettingsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DirectusServiceAccount) DeepCopyInto(out *DirectusServiceAccount) {
+func (in *DirectusSettingsStatus) DeepCopyInto(out *DirectusSettingsStatus) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusServiceAccount.
-func (in *DirectusServiceAccount) DeepCopy() *DirectusServiceAccount {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusSettingsStatus.
+func (in *DirectusSettingsStatus) DeepCopy() *DirectusSettingsStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DirectusServiceAccount)
+	out := new(DirectusSettingsStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -291,6 +1810,25 @@ func (in *DirectusSpec) DeepCopyInto(out *DirectusSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.CommonLabels != nil {
+		in, out := &in.CommonLabels, &out.CommonLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CommonAnnotations != nil {
+		in, out := &in.CommonAnnotations, &out.CommonAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PropagateLabels != nil {
+		in, out := &in.PropagateLabels, &out.PropagateLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.PodSecurityContext != nil {
 		in, out := &in.PodSecurityContext, &out.PodSecurityContext
 		*out = new(corev1.PodSecurityContext)
@@ -301,8 +1839,18 @@ func (in *DirectusSpec) DeepCopyInto(out *DirectusSpec) {
 		*out = new(corev1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Service = in.Service
+	in.Service.DeepCopyInto(&out.Service)
 	in.Ingress.DeepCopyInto(&out.Ingress)
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(DirectusGateway)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(DirectusRoute)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExtraEnvVars != nil {
 		in, out := &in.ExtraEnvVars, &out.ExtraEnvVars
 		*out = make([]corev1.EnvVar, len(*in))
@@ -317,6 +1865,21 @@ func (in *DirectusSpec) DeepCopyInto(out *DirectusSpec) {
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
 	out.Autoscaling = in.Autoscaling
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(DirectusMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(DirectusNetworkPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(DirectusPodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
@@ -336,6 +1899,18 @@ func (in *DirectusSpec) DeepCopyInto(out *DirectusSpec) {
 		*out = new(corev1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]DirectusOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ExtraVolumes != nil {
 		in, out := &in.ExtraVolumes, &out.ExtraVolumes
 		*out = make([]corev1.Volume, len(*in))
@@ -350,8 +1925,30 @@ func (in *DirectusSpec) DeepCopyInto(out *DirectusSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SecretsFrom != nil {
+		in, out := &in.SecretsFrom, &out.SecretsFrom
+		*out = new(DirectusSecretsFrom)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretRotation != nil {
+		in, out := &in.SecretRotation, &out.SecretRotation
+		*out = new(DirectusSecretRotation)
+		(*in).DeepCopyInto(*out)
+	}
 	out.Database = in.Database
 	out.Redis = in.Redis
+	out.Cache = in.Cache
+	out.RateLimiter = in.RateLimiter
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(DirectusEmail)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(DirectusBackupSchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.InitContainers != nil {
 		in, out := &in.InitContainers, &out.InitContainers
 		*out = make([]corev1.Container, len(*in))
@@ -388,6 +1985,30 @@ func (in *DirectusStatus) DeepCopyInto(out *DirectusStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(DirectusIngressStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(DirectusRouteStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(DirectusCertificateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastBackup != nil {
+		in, out := &in.LastBackup, &out.LastBackup
+		*out = new(DirectusBackupResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretRotationTime != nil {
+		in, out := &in.SecretRotationTime, &out.SecretRotationTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusStatus.
@@ -399,3 +2020,164 @@ func (in *DirectusStatus) DeepCopy() *DirectusStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusTranslation) DeepCopyInto(out *DirectusTranslation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusTranslation.
+func (in *DirectusTranslation) DeepCopy() *DirectusTranslation {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusTranslation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusUser) DeepCopyInto(out *DirectusUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusUser.
+func (in *DirectusUser) DeepCopy() *DirectusUser {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusUserList) DeepCopyInto(out *DirectusUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectusUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusUserList.
+func (in *DirectusUserList) DeepCopy() *DirectusUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectusUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusUserSpec) DeepCopyInto(out *DirectusUserSpec) {
+	*out = *in
+	out.DirectusRef = in.DirectusRef
+	if in.RoleRef != nil {
+		in, out := &in.RoleRef, &out.RoleRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(DirectusUserToken)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusUserSpec.
+func (in *DirectusUserSpec) DeepCopy() *DirectusUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusUserStatus) DeepCopyInto(out *DirectusUserStatus) {
+	*out = *in
+	if in.TokenRotationTime != nil {
+		in, out := &in.TokenRotationTime, &out.TokenRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusUserStatus.
+func (in *DirectusUserStatus) DeepCopy() *DirectusUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusUserToken) DeepCopyInto(out *DirectusUserToken) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusUserToken.
+func (in *DirectusUserToken) DeepCopy() *DirectusUserToken {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusUserToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectusVaultSecrets) DeepCopyInto(out *DirectusVaultSecrets) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectusVaultSecrets.
+func (in *DirectusVaultSecrets) DeepCopy() *DirectusVaultSecrets {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectusVaultSecrets)
+	in.DeepCopyInto(out)
+	return out
+}
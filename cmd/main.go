@@ -202,13 +202,115 @@ func main() {
 		os.Exit(1)
 	}
 
+	certificates, err := controller.CertificatesAvailable(mgr.GetRESTMapper())
+	if err != nil {
+		setupLog.Error(err, "unable to discover the cert-manager API")
+		os.Exit(1)
+	}
+	if certificates {
+		setupLog.Info("cert-manager Certificate found, spec.ingress.certificate is supported")
+	}
+	httpRoutes, err := controller.HTTPRoutesAvailable(mgr.GetRESTMapper())
+	if err != nil {
+		setupLog.Error(err, "unable to discover the Gateway API")
+		os.Exit(1)
+	}
+	if httpRoutes {
+		setupLog.Info("Gateway API HTTPRoute found, spec.gateway is supported")
+	}
+	openShiftRoutes, err := controller.OpenShiftRoutesAvailable(mgr.GetRESTMapper())
+	if err != nil {
+		setupLog.Error(err, "unable to discover the OpenShift Route API")
+		os.Exit(1)
+	}
+	if openShiftRoutes {
+		setupLog.Info("OpenShift Route API found, spec.route is supported")
+	}
+	serviceMonitors, err := controller.ServiceMonitorsAvailable(mgr.GetRESTMapper())
+	if err != nil {
+		setupLog.Error(err, "unable to discover the ServiceMonitor API")
+		os.Exit(1)
+	}
+	if serviceMonitors {
+		setupLog.Info("ServiceMonitor API found, spec.metrics.serviceMonitor is supported")
+	}
+
 	if err := (&controller.DirectusReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:          mgr.GetClient(),
+		Scheme:          mgr.GetScheme(),
+		Recorder:        mgr.GetEventRecorderFor("directus-controller"),
+		Certificates:    certificates,
+		HTTPRoutes:      httpRoutes,
+		OpenShiftRoutes: openShiftRoutes,
+		ServiceMonitors: serviceMonitors,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Directus")
 		os.Exit(1)
 	}
+	if err := (&controller.DirectusBackupReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("directusbackup-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DirectusBackup")
+		os.Exit(1)
+	}
+	if err := (&controller.DirectusRestoreReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("directusrestore-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DirectusRestore")
+		os.Exit(1)
+	}
+	if err := (&controller.DirectusSchemaReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("directusschema-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DirectusSchema")
+		os.Exit(1)
+	}
+	if err := (&controller.DirectusPolicyReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("directuspolicy-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DirectusPolicy")
+		os.Exit(1)
+	}
+	if err := (&controller.DirectusRoleReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("directusrole-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DirectusRole")
+		os.Exit(1)
+	}
+	if err := (&controller.DirectusUserReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("directususer-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DirectusUser")
+		os.Exit(1)
+	}
+	if err := (&controller.DirectusFlowReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("directusflow-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DirectusFlow")
+		os.Exit(1)
+	}
+	if err := (&controller.DirectusSettingsReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("directussettings-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DirectusSettings")
+		os.Exit(1)
+	}
 	// +kubebuilder:scaffold:builder
 
 	if metricsCertWatcher != nil {
@@ -0,0 +1,372 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+const (
+	// backupStoreContainer is the container reporting the backup result
+	backupStoreContainer = "store"
+
+	defaultPostgresImage = "postgres:16-alpine"
+	defaultMySQLImage    = "mysql:8.4"
+	defaultBusyboxImage  = "busybox:1.36"
+	defaultAWSCLIImage   = "amazon/aws-cli:2.17.0"
+)
+
+// dumpScript writes the database dump, and the uploads archive when mounted, to /work
+const dumpScript = `set -eu
+STAMP=$(date -u +%Y%m%d%H%M%S)
+case "$DUMP_TOOL" in
+  pg_dump)
+    PGPASSWORD="${DB_PASSWORD:-}" pg_dump -h "$DB_HOST" -p "$DB_PORT" -U "$DB_USER" -d "$DB_DATABASE" \
+      --no-owner --clean --if-exists > /work/dump.sql ;;
+  mysqldump)
+    MYSQL_PWD="${DB_PASSWORD:-}" mysqldump -h "$DB_HOST" -P "$DB_PORT" -u "$DB_USER" \
+      --single-transaction --routines "$DB_DATABASE" > /work/dump.sql ;;
+esac
+gzip -c /work/dump.sql > "/work/${BACKUP_NAME}-${STAMP}.sql.gz"
+rm /work/dump.sql
+if [ -d /uploads ]; then
+  tar -czf "/work/${BACKUP_NAME}-${STAMP}-uploads.tar.gz" -C /uploads .
+fi
+`
+
+// pvcStoreScript copies the backup into the claim and applies the retention
+const pvcStoreScript = `set -eu
+DEST="/backup/${BACKUP_DIR}"
+mkdir -p "$DEST"
+cp /work/*.gz "$DEST/"
+cd "$DEST"
+if [ "$KEEP_LAST" -gt 0 ]; then
+  ls -1 *.sql.gz | sort -r | tail -n +$((KEEP_LAST + 1)) | while read -r f; do
+    rm -f "$f" "${f%.sql.gz}-uploads.tar.gz"
+  done
+fi
+if [ "$MAX_AGE_DAYS" -gt 0 ]; then
+  CUTOFF=$(date -u -d "@$(( $(date -u +%s) - MAX_AGE_DAYS * 86400 ))" +%Y%m%d%H%M%S)
+  for f in *.gz; do
+    STAMP=$(echo "$f" | sed -n 's/.*-\([0-9]\{14\}\).*/\1/p')
+    if [ -n "$STAMP" ] && [ "$STAMP" -lt "$CUTOFF" ]; then rm -f "$f"; fi
+  done
+fi
+DUMP=$(basename /work/*.sql.gz)
+SIZE=$(cat /work/*.gz | wc -c)
+printf '{"location":"%s","sizeBytes":%s}' "pvc://${CLAIM_NAME}/${BACKUP_DIR}/${DUMP}" "$SIZE" > /dev/termination-log
+`
+
+// s3StoreScript uploads the backup to the bucket and applies the retention
+const s3StoreScript = `set -eu
+DEST="s3://${S3_BUCKET}/${S3_PREFIX}"
+for f in /work/*.gz; do aws s3 cp "$f" "$DEST/$(basename "$f")"; done
+list() { aws s3 ls "$DEST/" | awk '{print $4}'; }
+if [ "$KEEP_LAST" -gt 0 ]; then
+  list | grep '\.sql\.gz$' | sort -r | tail -n +$((KEEP_LAST + 1)) | while read -r f; do
+    aws s3 rm "$DEST/$f"
+    aws s3 rm "$DEST/${f%.sql.gz}-uploads.tar.gz" || true
+  done
+fi
+if [ "$MAX_AGE_DAYS" -gt 0 ]; then
+  CUTOFF=$(date -u -d "@$(( $(date -u +%s) - MAX_AGE_DAYS * 86400 ))" +%Y%m%d%H%M%S)
+  list | while read -r f; do
+    STAMP=$(echo "$f" | sed -n 's/.*-\([0-9]\{14\}\).*/\1/p')
+    if [ -n "$STAMP" ] && [ "$STAMP" -lt "$CUTOFF" ]; then aws s3 rm "$DEST/$f"; fi
+  done
+fi
+DUMP=$(basename /work/*.sql.gz)
+SIZE=$(cat /work/*.gz | wc -c)
+printf '{"location":"%s","sizeBytes":%s}' "$DEST/$DUMP" "$SIZE" > /dev/termination-log
+`
+
+// backupOptions describes a single backup run
+type backupOptions struct {
+	// Snapshot names an on-demand backup, stored apart from the scheduled
+	// backups so that their retention never removes it
+	Snapshot         string
+	Image            string
+	UploadsClaimName string
+	Storage          directusv1.DirectusBackupStorage
+	Retention        directusv1.DirectusBackupRetention
+	Resources        corev1.ResourceRequirements
+}
+
+// dumpTool returns the dump tool for a database engine, empty if unsupported
+func dumpTool(engine string) string {
+	switch engine {
+	case "postgresql", "postgres", "pg", "cockroachdb":
+		return "pg_dump"
+	case "mysql", "mysql2", "mariadb":
+		return "mysqldump"
+	}
+	return ""
+}
+
+// defaultDatabasePort returns the default port of the dump tool's database
+func defaultDatabasePort(tool string) int32 {
+	if tool == "mysqldump" {
+		return 3306
+	}
+	return 5432
+}
+
+// backupLabels returns the labels of backup Jobs and their pods, kept apart
+// from the Directus pod labels so that the Service never selects them
+func backupLabels(directus *directusv1.Directus) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "directus",
+		"app.kubernetes.io/instance":   directus.Name,
+		"app.kubernetes.io/component":  "backup",
+		"app.kubernetes.io/managed-by": "directus-operator",
+	}
+}
+
+// backupDirectory returns the directory backups are stored in, relative to the storage root
+func backupDirectory(directus *directusv1.Directus, snapshot string) string {
+	if snapshot != "" {
+		return path.Join(directus.Name, "snapshots")
+	}
+	return directus.Name
+}
+
+// backupS3Prefix returns the key prefix backups of the instance are stored under
+func backupS3Prefix(directus *directusv1.Directus, s3 *directusv1.DirectusBackupS3, snapshot string) string {
+	return strings.Trim(path.Join(s3.Prefix, backupDirectory(directus, snapshot)), "/")
+}
+
+// buildBackupPodSpec returns the pod running a single backup: an init container
+// dumps the database into a scratch volume and the store container copies it
+// to the target, applies the retention and reports the result in its
+// termination message
+func buildBackupPodSpec(directus *directusv1.Directus, opts backupOptions) (*corev1.PodSpec, error) {
+	tool := dumpTool(directus.Spec.Database.Engine)
+	if tool == "" {
+		return nil, fmt.Errorf("database engine %q does not support backups", directus.Spec.Database.Engine)
+	}
+	if opts.Storage.PersistentVolumeClaim == "" && opts.Storage.S3 == nil {
+		return nil, fmt.Errorf("backup storage requires a persistentVolumeClaim or s3 target")
+	}
+
+	name := directus.Name
+	if opts.Snapshot != "" {
+		name = opts.Snapshot
+	}
+
+	dumpEnv := append([]corev1.EnvVar{
+		{Name: "DUMP_TOOL", Value: tool},
+		{Name: "BACKUP_NAME", Value: name},
+	}, databaseEnv(directus, tool)...)
+
+	volumes := []corev1.Volume{
+		{Name: "work", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+	}
+	dumpMounts := []corev1.VolumeMount{{Name: "work", MountPath: "/work"}}
+	if opts.UploadsClaimName != "" {
+		volumes = append(volumes, corev1.Volume{
+			Name: "uploads",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: opts.UploadsClaimName,
+					ReadOnly:  true,
+				},
+			},
+		})
+		dumpMounts = append(dumpMounts, corev1.VolumeMount{Name: "uploads", MountPath: "/uploads", ReadOnly: true})
+	}
+
+	store := corev1.Container{
+		Name:    backupStoreContainer,
+		Command: []string{"/bin/sh", "-c"},
+		Env: []corev1.EnvVar{
+			{Name: "BACKUP_DIR", Value: backupDirectory(directus, opts.Snapshot)},
+			{Name: "KEEP_LAST", Value: strconv.Itoa(int(opts.Retention.KeepLast))},
+			{Name: "MAX_AGE_DAYS", Value: strconv.Itoa(int(opts.Retention.MaxAgeDays))},
+		},
+		VolumeMounts:             []corev1.VolumeMount{{Name: "work", MountPath: "/work", ReadOnly: true}},
+		Resources:                opts.Resources,
+		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+	}
+
+	if s3 := opts.Storage.S3; s3 != nil {
+		store.Image = defaultAWSCLIImage
+		store.Args = []string{s3StoreScript}
+		store.Env = append(store.Env, s3Env(s3, backupS3Prefix(directus, s3, opts.Snapshot))...)
+	} else {
+		store.Image = defaultBusyboxImage
+		store.Args = []string{pvcStoreScript}
+		store.Env = append(store.Env, corev1.EnvVar{Name: "CLAIM_NAME", Value: opts.Storage.PersistentVolumeClaim})
+		store.VolumeMounts = append(store.VolumeMounts, corev1.VolumeMount{Name: "backup", MountPath: "/backup"})
+		volumes = append(volumes, corev1.Volume{
+			Name: "backup",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: opts.Storage.PersistentVolumeClaim,
+				},
+			},
+		})
+	}
+
+	return &corev1.PodSpec{
+		RestartPolicy:    corev1.RestartPolicyNever,
+		ImagePullSecrets: directus.Spec.ImagePullSecrets,
+		SecurityContext:  directus.Spec.PodSecurityContext,
+		InitContainers: []corev1.Container{
+			{
+				Name:         "dump",
+				Image:        databaseClientImage(tool, opts.Image),
+				Command:      []string{"/bin/sh", "-c"},
+				Args:         []string{dumpScript},
+				Env:          dumpEnv,
+				VolumeMounts: dumpMounts,
+				Resources:    opts.Resources,
+			},
+		},
+		Containers: []corev1.Container{store},
+		Volumes:    volumes,
+	}, nil
+}
+
+// databaseClientImage returns the image providing the client tools for the dump tool
+func databaseClientImage(tool, image string) string {
+	if image != "" {
+		return image
+	}
+	if tool == "mysqldump" {
+		return defaultMySQLImage
+	}
+	return defaultPostgresImage
+}
+
+// databaseEnv returns the connection settings of the instance database
+func databaseEnv(directus *directusv1.Directus, tool string) []corev1.EnvVar {
+	database := directus.Spec.Database
+	port := database.Port
+	if port == 0 {
+		port = defaultDatabasePort(tool)
+	}
+
+	env := []corev1.EnvVar{
+		{Name: "DB_HOST", Value: database.Host},
+		{Name: "DB_PORT", Value: strconv.Itoa(int(port))},
+		{Name: "DB_DATABASE", Value: database.Database},
+		{Name: "DB_USER", Value: database.Username},
+	}
+	if database.ExistingSecret != "" {
+		env = append(env, secretEnvVar("DB_PASSWORD", database.ExistingSecret, "password"))
+	}
+	return env
+}
+
+// s3Env returns the AWS CLI environment for an S3 target
+func s3Env(s3 *directusv1.DirectusBackupS3, prefix string) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{Name: "S3_BUCKET", Value: s3.Bucket},
+		{Name: "S3_PREFIX", Value: prefix},
+	}
+	if s3.Region != "" {
+		env = append(env, corev1.EnvVar{Name: "AWS_DEFAULT_REGION", Value: s3.Region})
+	}
+	if s3.Endpoint != "" {
+		env = append(env, corev1.EnvVar{Name: "AWS_ENDPOINT_URL", Value: s3.Endpoint})
+	}
+	if s3.ExistingSecret != "" {
+		env = append(env,
+			secretEnvVar("AWS_ACCESS_KEY_ID", s3.ExistingSecret, "accessKeyId"),
+			secretEnvVar("AWS_SECRET_ACCESS_KEY", s3.ExistingSecret, "secretAccessKey"),
+		)
+	}
+	return env
+}
+
+// backupResult reads the result a finished backup Job reported through the
+// termination message of its store container
+func backupResult(ctx context.Context, c client.Client, job *batchv1.Job) (*directusv1.DirectusBackupResult, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(job.Namespace),
+		client.MatchingLabels{batchv1.JobNameLabel: job.Name}); err != nil {
+		return nil, err
+	}
+
+	result := &directusv1.DirectusBackupResult{CompletionTime: job.Status.CompletionTime}
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			terminated := status.State.Terminated
+			if status.Name != backupStoreContainer || terminated == nil || terminated.ExitCode != 0 {
+				continue
+			}
+			if err := json.Unmarshal([]byte(terminated.Message), result); err != nil {
+				return nil, fmt.Errorf("failed to parse backup result of Job %s: %w", job.Name, err)
+			}
+			return result, nil
+		}
+	}
+	return result, nil
+}
+
+// jobSucceeded reports whether a Job completed successfully
+func jobSucceeded(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobComplete && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// jobFailed reports whether a Job failed and will not be retried
+func jobFailed(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// completedAfter reports whether completion time a is later than b
+func completedAfter(a, b *metav1.Time) bool {
+	if b == nil {
+		return a != nil
+	}
+	return a != nil && a.After(b.Time)
+}
+
+// secretEnvVar returns an environment variable read from a secret key
+func secretEnvVar(name, secretName, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			},
+		},
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+	"github.com/example/directus-operator/internal/directusapi"
+)
+
+// adminCredentialsSecretName returns the Secret recording the admin
+// credentials last applied to the instance. Directus only reads ADMIN_EMAIL
+// and ADMIN_PASSWORD on first bootstrap, so later changes are applied through
+// the API, logging in with the recorded credentials.
+func adminCredentialsSecretName(directus *directusv1.Directus) string {
+	return directus.Name + "-admin-credentials"
+}
+
+// appliedAdminCredentials returns the admin credentials last applied to the
+// instance, ok is false if none were recorded yet
+func appliedAdminCredentials(ctx context.Context, c client.Client, directus *directusv1.Directus) (email, password string, ok bool, err error) {
+	secret := &corev1.Secret{}
+	err = c.Get(ctx, types.NamespacedName{Name: adminCredentialsSecretName(directus), Namespace: directus.Namespace}, secret)
+	if errors.IsNotFound(err) {
+		return "", "", false, nil
+	} else if err != nil {
+		return "", "", false, err
+	}
+	return string(secret.Data["ADMIN_EMAIL"]), string(secret.Data["ADMIN_PASSWORD"]), true, nil
+}
+
+// reconcileAdminCredentials applies changes of the admin email or password to
+// the running instance and rotates the password when requested
+func (r *DirectusReconciler) reconcileAdminCredentials(ctx context.Context, directus *directusv1.Directus) error {
+	if directus.Status.Phase != "Running" {
+		return nil
+	}
+	if err := r.applyAdminCredentials(ctx, directus); err != nil {
+		return err
+	}
+
+	if _, ok := directus.Annotations[directusv1.RotateAdminPasswordAnnotation]; !ok {
+		return nil
+	}
+	if err := r.rotateAdminPassword(ctx, directus); err != nil {
+		return err
+	}
+	return r.applyAdminCredentials(ctx, directus)
+}
+
+// applyAdminCredentials updates the admin user when the configured credentials
+// differ from the applied ones. It first logs in with the configured
+// credentials, which succeeds when they are already in effect, e.g. on the
+// first reconcile of an instance or after recording them failed. Otherwise it
+// logs in with the recorded credentials and changes them to the configured ones.
+func (r *DirectusReconciler) applyAdminCredentials(ctx context.Context, directus *directusv1.Directus) error {
+	email := adminEmail(directus)
+	password, err := adminPassword(ctx, r.Client, directus)
+	// Credentials the operator cannot read are managed elsewhere
+	if stderrors.Is(err, errNoAdminPassword) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	appliedEmail, appliedPassword, ok, err := appliedAdminCredentials(ctx, r.Client, directus)
+	if err != nil {
+		return err
+	}
+	if ok && appliedEmail == email && appliedPassword == password {
+		return nil
+	}
+
+	api := r.API.anonymousClient(directus)
+	if configuredErr := api.Login(ctx, email, password); configuredErr != nil {
+		if !ok {
+			return fmt.Errorf("failed to log in as admin with the configured credentials and none were recorded: %w", configuredErr)
+		}
+		if err := api.Login(ctx, appliedEmail, appliedPassword); err != nil {
+			return fmt.Errorf("failed to log in as admin with the configured or the recorded credentials: %w", err)
+		}
+		changes := map[string]string{"email": email, "password": password}
+		if _, err := directusapi.UpdateItem[directusapi.User](ctx, api, "/users", "me", changes); err != nil {
+			return fmt.Errorf("failed to update admin user: %w", err)
+		}
+		r.recordEvent(directus, corev1.EventTypeNormal, ReasonAdminCredentialsUpdated, "Applied admin credentials for %s", email)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      adminCredentialsSecretName(directus),
+			Namespace: directus.Namespace,
+			Labels:    r.getLabels(directus),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"ADMIN_EMAIL":    []byte(email),
+			"ADMIN_PASSWORD": []byte(password),
+		},
+	}
+	if err := controllerutil.SetControllerReference(directus, secret, r.Scheme); err != nil {
+		return err
+	}
+	r.applyCommonMetadata(directus, secret)
+	if !ok {
+		return r.Create(ctx, secret)
+	}
+	return r.Update(ctx, secret)
+}
+
+// rotateAdminPassword writes a new random password to the application secret
+// and removes the rotation annotation. Only the application secret created by
+// the operator is changed, passwords in other secrets are managed elsewhere.
+func (r *DirectusReconciler) rotateAdminPassword(ctx context.Context, directus *directusv1.Directus) error {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: applicationSecretName(directus), Namespace: directus.Namespace}, secret); err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(secret, directus) {
+		return fmt.Errorf("cannot rotate the admin password, secret %s is not managed by the operator", secret.Name)
+	}
+
+	password, err := randomToken(24)
+	if err != nil {
+		return err
+	}
+	secret.Data["ADMIN_PASSWORD"] = []byte(password)
+	if err := r.Update(ctx, secret); err != nil {
+		return err
+	}
+	r.recordEvent(directus, corev1.EventTypeNormal, ReasonAdminPasswordRotated, "Generated new admin password in Secret %s", secret.Name)
+
+	return r.removeAnnotation(ctx, directus, directusv1.RotateAdminPasswordAnnotation)
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+	"github.com/example/directus-operator/internal/directusapi"
+)
+
+// DirectusAPI builds REST API clients for managed Directus instances
+type DirectusAPI struct {
+	// HTTPClient is used for all API calls, a default client is used when nil
+	HTTPClient *http.Client
+	// URLFor overrides the base URL of an instance (the in-cluster Service URL
+	// by default), e.g. to point tests at a fake Directus server
+	URLFor func(directus *directusv1.Directus) string
+}
+
+// baseURL returns the URL the operator uses to reach the instance API
+func (a DirectusAPI) baseURL(directus *directusv1.Directus) string {
+	if a.URLFor != nil {
+		return a.URLFor(directus)
+	}
+	port := directus.Spec.Service.Port
+	if port == 0 {
+		port = 80
+	}
+	return fmt.Sprintf("http://%s.%s.svc:%d", directus.Name, directus.Namespace, port)
+}
+
+// anonymousClient returns an unauthenticated client for the instance
+func (a DirectusAPI) anonymousClient(directus *directusv1.Directus) *directusapi.Client {
+	return directusapi.NewClient(a.baseURL(directus), a.HTTPClient)
+}
+
+// adminClient returns a client logged in with the admin credentials last
+// applied to the instance, or the configured ones before any were recorded or
+// when the recorded ones are rejected
+func (a DirectusAPI) adminClient(ctx context.Context, c client.Client, directus *directusv1.Directus) (*directusapi.Client, error) {
+	email, password, err := adminCredentials(ctx, c, directus)
+	if err != nil {
+		return nil, err
+	}
+
+	api := a.anonymousClient(directus)
+	loginErr := api.Login(ctx, email, password)
+	if loginErr == nil {
+		return api, nil
+	}
+	configuredPassword, err := adminPassword(ctx, c, directus)
+	if err == nil && (adminEmail(directus) != email || configuredPassword != password) &&
+		api.Login(ctx, adminEmail(directus), configuredPassword) == nil {
+		return api, nil
+	}
+	return nil, fmt.Errorf("failed to log in as admin: %w", loginErr)
+}
+
+// healthTokens caches the admin access token used to read detailed health
+// checks, so a reconcile does not log in to every instance it looks at
+var healthTokens = &tokenCache{tokens: map[string]cachedToken{}}
+
+type cachedToken struct {
+	credentials [sha256.Size]byte
+	token       string
+}
+
+type tokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]cachedToken
+}
+
+// adminHealth returns the detailed health report of the instance. It reuses a
+// cached admin token and only logs in again when there is none, the admin
+// credentials changed or Directus rejects the token (e.g. once it expired).
+func (a DirectusAPI) adminHealth(ctx context.Context, c client.Client, directus *directusv1.Directus) (*directusapi.Health, error) {
+	email, password, err := adminCredentials(ctx, c, directus)
+	if err != nil {
+		return nil, err
+	}
+	key := a.baseURL(directus)
+	credentials := sha256.Sum256([]byte(email + "\x00" + password))
+
+	api := a.anonymousClient(directus)
+	healthTokens.mu.Lock()
+	cached, ok := healthTokens.tokens[key]
+	healthTokens.mu.Unlock()
+	if ok && cached.credentials == credentials {
+		api.SetToken(cached.token)
+		health, err := api.Health(ctx)
+		if apiErr, isAPIErr := err.(*directusapi.APIError); !isAPIErr || apiErr.StatusCode != http.StatusUnauthorized {
+			return health, err
+		}
+	}
+
+	api, err = a.adminClient(ctx, c, directus)
+	if err != nil {
+		healthTokens.mu.Lock()
+		delete(healthTokens.tokens, key)
+		healthTokens.mu.Unlock()
+		return nil, err
+	}
+	healthTokens.mu.Lock()
+	healthTokens.tokens[key] = cachedToken{credentials: credentials, token: api.Token()}
+	healthTokens.mu.Unlock()
+	return api.Health(ctx)
+}
+
+// adminCredentials returns the admin credentials last applied to the
+// instance, or the configured ones before any were recorded
+func adminCredentials(ctx context.Context, c client.Client, directus *directusv1.Directus) (string, string, error) {
+	email, password, ok, err := appliedAdminCredentials(ctx, c, directus)
+	if err == nil && !ok {
+		email = adminEmail(directus)
+		password, err = adminPassword(ctx, c, directus)
+	}
+	return email, password, err
+}
+
+// instanceNotReadyError reports that a referenced instance cannot be managed
+// through its API yet, the caller should retry later
+type instanceNotReadyError struct {
+	reason  string
+	message string
+}
+
+func (e *instanceNotReadyError) Error() string {
+	return e.message
+}
+
+// connectInstance returns the referenced instance and an admin client for it
+func (a DirectusAPI) connectInstance(ctx context.Context, c client.Client, namespace string, ref corev1.LocalObjectReference) (*directusv1.Directus, *directusapi.Client, error) {
+	directus := &directusv1.Directus{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, directus); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil, &instanceNotReadyError{"DirectusNotFound", fmt.Sprintf("Directus %s not found", ref.Name)}
+		}
+		return nil, nil, err
+	}
+	if directus.Status.Phase != "Running" {
+		return nil, nil, &instanceNotReadyError{"DirectusNotReady", fmt.Sprintf("Waiting for Directus %s to become ready", ref.Name)}
+	}
+	applyDefaults(directus)
+
+	api, err := a.adminClient(ctx, c, directus)
+	if err != nil {
+		return nil, nil, err
+	}
+	return directus, api, nil
+}
+
+// adminEmail returns the configured admin email or the operator default
+func adminEmail(directus *directusv1.Directus) string {
+	if directus.Spec.AdminEmail != "" {
+		return directus.Spec.AdminEmail
+	}
+	return "directus-admin@example.com"
+}
+
+// errNoAdminPassword is returned when no secret holds ADMIN_PASSWORD, e.g.
+// when it is injected from Vault
+var errNoAdminPassword = stderrors.New("no ADMIN_PASSWORD found")
+
+// adminPassword reads ADMIN_PASSWORD from the application secret, falling back
+// to the attached existing secrets
+func adminPassword(ctx context.Context, c client.Client, directus *directusv1.Directus) (string, error) {
+	secretNames := append([]string{applicationSecretName(directus)}, directus.Spec.AttachExistingSecrets...)
+	for _, name := range secretNames {
+		secret := &corev1.Secret{}
+		err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: directus.Namespace}, secret)
+		if err != nil && errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		if password, ok := secret.Data["ADMIN_PASSWORD"]; ok {
+			return string(password), nil
+		}
+	}
+	return "", fmt.Errorf("%w for Directus %s/%s", errNoAdminPassword, directus.Namespace, directus.Name)
+}
+
+// applicationSecretName returns the name of the application secret
+func applicationSecretName(directus *directusv1.Directus) string {
+	if directus.Spec.ApplicationSecretName != "" {
+		return directus.Spec.ApplicationSecretName
+	}
+	return directus.Name + "-application-secret"
+}
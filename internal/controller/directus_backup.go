@@ -0,0 +1,161 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// backupEnabled reports whether scheduled backups are configured for a supported engine
+func backupEnabled(directus *directusv1.Directus) bool {
+	return directus.Spec.Backup != nil && directus.Spec.Backup.Enabled && dumpTool(directus.Spec.Database.Engine) != ""
+}
+
+func (r *DirectusReconciler) reconcileBackup(ctx context.Context, directus *directusv1.Directus) error {
+	name := directus.Name + "-backup"
+
+	if !backupEnabled(directus) {
+		return r.deleteChild(ctx, directus, &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: directus.Namespace},
+		})
+	}
+
+	backup := directus.Spec.Backup
+	podSpec, err := buildBackupPodSpec(directus, backupOptions{
+		Image:            backup.Image,
+		UploadsClaimName: backup.UploadsClaimName,
+		Storage:          backup.Storage,
+		Retention:        backup.Retention,
+		Resources:        backup.Resources,
+	})
+	if err != nil {
+		return err
+	}
+
+	schedule := backup.Schedule
+	if schedule == "" {
+		schedule = "0 3 * * *"
+	}
+
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: directus.Namespace,
+			Labels:    backupLabels(directus),
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:          schedule,
+			Suspend:           &backup.Suspend,
+			ConcurrencyPolicy: batchv1.ForbidConcurrent,
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: backupLabels(directus),
+				},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: backupLabels(directus),
+						},
+						Spec: *podSpec,
+					},
+				},
+			},
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(directus, cronJob, r.Scheme); err != nil {
+		return err
+	}
+
+	found := &batchv1.CronJob{}
+	err = r.Get(ctx, types.NamespacedName{Name: cronJob.Name, Namespace: cronJob.Namespace}, found)
+	if err != nil && errors.IsNotFound(err) {
+		return r.createChild(ctx, directus, cronJob)
+	} else if err != nil {
+		return err
+	}
+
+	// Update CronJob
+	found.Labels = cronJob.Labels
+	found.Spec = cronJob.Spec
+	return r.updateChild(ctx, directus, found)
+}
+
+// setBackupStatus records the last successful scheduled backup
+func (r *DirectusReconciler) setBackupStatus(ctx context.Context, directus *directusv1.Directus) error {
+	if directus.Spec.Backup == nil || !directus.Spec.Backup.Enabled {
+		return nil
+	}
+
+	jobs := &batchv1.JobList{}
+	if err := r.List(ctx, jobs, client.InNamespace(directus.Namespace), client.MatchingLabels(backupLabels(directus))); err != nil {
+		return err
+	}
+
+	var latest *batchv1.Job
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if !ownedByCronJob(job, directus.Name+"-backup") {
+			continue
+		}
+		if jobSucceeded(job) && (latest == nil || completedAfter(job.Status.CompletionTime, latest.Status.CompletionTime)) {
+			latest = job
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+	if directus.Status.LastBackup != nil && !completedAfter(latest.Status.CompletionTime, directus.Status.LastBackup.CompletionTime) {
+		return nil
+	}
+
+	result, err := backupResult(ctx, r.Client, latest)
+	if err != nil {
+		return err
+	}
+	directus.Status.LastBackup = result
+	return nil
+}
+
+// ownedByCronJob reports whether a Job was created by the named CronJob
+func ownedByCronJob(job *batchv1.Job, cronJobName string) bool {
+	owner := metav1.GetControllerOf(job)
+	return owner != nil && owner.Kind == "CronJob" && owner.Name == cronJobName
+}
+
+// backupJobToDirectus maps backup Jobs to the Directus instance they back up
+func backupJobToDirectus(_ context.Context, obj client.Object) []reconcile.Request {
+	labels := obj.GetLabels()
+	if labels["app.kubernetes.io/managed-by"] != "directus-operator" || labels["app.kubernetes.io/component"] != "backup" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Name:      labels["app.kubernetes.io/instance"],
+		Namespace: obj.GetNamespace(),
+	}}}
+}
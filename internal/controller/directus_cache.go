@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strconv"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// buildStoreConfig adds the cache, rate limiter, session, synchronization and
+// messenger settings to the ConfigMap data. All stores share the configured
+// Redis so that state is consistent across replicas.
+func (r *DirectusReconciler) buildStoreConfig(directus *directusv1.Directus, data map[string]string) {
+	store := "memory"
+	if directus.Spec.Redis.Enabled {
+		store = "redis"
+	}
+
+	// Data cache
+	data["CACHE_ENABLED"] = strconv.FormatBool(directus.Spec.Cache.Enabled)
+	if directus.Spec.Cache.Enabled {
+		data["CACHE_STORE"] = store
+		data["CACHE_AUTO_PURGE"] = strconv.FormatBool(directus.Spec.Cache.AutoPurge)
+		if directus.Spec.Cache.TTL != "" {
+			data["CACHE_TTL"] = directus.Spec.Cache.TTL
+		}
+	}
+
+	// Rate limiter
+	data["RATE_LIMITER_ENABLED"] = strconv.FormatBool(directus.Spec.RateLimiter.Enabled)
+	if directus.Spec.RateLimiter.Enabled {
+		data["RATE_LIMITER_STORE"] = store
+		if directus.Spec.RateLimiter.Points > 0 {
+			data["RATE_LIMITER_POINTS"] = strconv.Itoa(int(directus.Spec.RateLimiter.Points))
+		}
+		if directus.Spec.RateLimiter.Duration > 0 {
+			data["RATE_LIMITER_DURATION"] = strconv.Itoa(int(directus.Spec.RateLimiter.Duration))
+		}
+	}
+
+	// Sessions, synchronization and the messenger used between replicas
+	data["SESSION_STORE"] = store
+	data["SYNCHRONIZATION_STORE"] = store
+	data["MESSENGER_STORE"] = store
+
+	// Namespace prefix, keeps instances sharing a Redis apart
+	if directus.Spec.Cache.Namespace != "" {
+		data["CACHE_NAMESPACE"] = directus.Spec.Cache.Namespace
+		data["SYNCHRONIZATION_NAMESPACE"] = directus.Spec.Cache.Namespace
+		data["MESSENGER_NAMESPACE"] = directus.Spec.Cache.Namespace
+	}
+}
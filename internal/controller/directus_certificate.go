@@ -0,0 +1,206 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// certificateGVK is the cert-manager Certificate kind
+var certificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+// CertificatesAvailable reports whether the cluster serves the cert-manager
+// Certificate kind. It is checked once at startup.
+func CertificatesAvailable(mapper meta.RESTMapper) (bool, error) {
+	return kindAvailable(mapper, certificateGVK)
+}
+
+// certificateEnabled reports whether a Certificate is issued for the ingress
+// hosts, which requires cert-manager and at least one host
+func (r *DirectusReconciler) certificateEnabled(directus *directusv1.Directus) bool {
+	return r.Certificates && r.ingressEnabled(directus) && directus.Spec.Ingress.Certificate != nil &&
+		len(certificateHosts(directus)) > 0
+}
+
+// certificateSecretName returns the name of the TLS secret of the ingress certificate
+func certificateSecretName(directus *directusv1.Directus) string {
+	if name := directus.Spec.Ingress.Certificate.SecretName; name != "" {
+		return name
+	}
+	return directus.Name + "-tls"
+}
+
+// certificateHosts returns the ingress hosts the certificate is issued for
+func certificateHosts(directus *directusv1.Directus) []string {
+	hosts := []string{}
+	for _, host := range directus.Spec.Ingress.Hosts {
+		if host.Host != "" {
+			hosts = append(hosts, host.Host)
+		}
+	}
+	return hosts
+}
+
+// ingressTLS returns the TLS configuration of the Ingress, the certificate
+// secret when a Certificate is issued
+func (r *DirectusReconciler) ingressTLS(directus *directusv1.Directus) []networkingv1.IngressTLS {
+	if !r.certificateEnabled(directus) {
+		return directus.Spec.Ingress.TLS
+	}
+	return []networkingv1.IngressTLS{{
+		Hosts:      certificateHosts(directus),
+		SecretName: certificateSecretName(directus),
+	}}
+}
+
+// reconcileCertificate creates or updates the cert-manager Certificate for the
+// ingress hosts, and removes it when no longer configured
+func (r *DirectusReconciler) reconcileCertificate(ctx context.Context, directus *directusv1.Directus) error {
+	if !r.Certificates {
+		return nil
+	}
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(certificateGVK)
+	certificate.SetName(directus.Name)
+	certificate.SetNamespace(directus.Namespace)
+
+	if !r.certificateEnabled(directus) {
+		return r.deleteChild(ctx, directus, certificate)
+	}
+
+	spec := buildCertificateSpec(directus)
+	certificate.SetLabels(r.getLabels(directus))
+	certificate.Object["spec"] = spec
+	if err := controllerutil.SetControllerReference(directus, certificate, r.Scheme); err != nil {
+		return err
+	}
+
+	found := &unstructured.Unstructured{}
+	found.SetGroupVersionKind(certificateGVK)
+	err := r.Get(ctx, types.NamespacedName{Name: certificate.GetName(), Namespace: directus.Namespace}, found)
+	if err != nil && errors.IsNotFound(err) {
+		return r.createChild(ctx, directus, certificate)
+	} else if err != nil {
+		return err
+	}
+
+	found.SetLabels(certificate.GetLabels())
+	found.Object["spec"] = spec
+	return r.updateChild(ctx, directus, found)
+}
+
+// buildCertificateSpec returns the spec of the Certificate
+func buildCertificateSpec(directus *directusv1.Directus) map[string]any {
+	issuer := directus.Spec.Ingress.Certificate.IssuerRef
+	kind := issuer.Kind
+	if kind == "" {
+		kind = "Issuer"
+	}
+	group := issuer.Group
+	if group == "" {
+		group = certificateGVK.Group
+	}
+
+	dnsNames := []any{}
+	for _, host := range certificateHosts(directus) {
+		dnsNames = append(dnsNames, host)
+	}
+	return map[string]any{
+		"secretName": certificateSecretName(directus),
+		"dnsNames":   dnsNames,
+		"issuerRef": map[string]any{
+			"name":  issuer.Name,
+			"kind":  kind,
+			"group": group,
+		},
+	}
+}
+
+// certificateStatus is the part of the Certificate status the operator reports
+type certificateStatus struct {
+	Conditions  []metav1.Condition `json:"conditions,omitempty"`
+	NotAfter    *metav1.Time       `json:"notAfter,omitempty"`
+	RenewalTime *metav1.Time       `json:"renewalTime,omitempty"`
+}
+
+// setCertificateStatus sets CertificateReady and the certificate expiry from
+// the status of the Certificate
+func (r *DirectusReconciler) setCertificateStatus(ctx context.Context, directus *directusv1.Directus) error {
+	if !r.certificateEnabled(directus) {
+		meta.RemoveStatusCondition(&directus.Status.Conditions, directusv1.ConditionCertificateReady)
+		directus.Status.Certificate = nil
+		return nil
+	}
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(certificateGVK)
+	err := r.Get(ctx, types.NamespacedName{Name: directus.Name, Namespace: directus.Namespace}, certificate)
+	if err != nil && errors.IsNotFound(err) {
+		setCondition(directus, directusv1.ConditionCertificateReady, metav1.ConditionFalse, "CertificateNotFound",
+			"Certificate has not been created")
+		directus.Status.Certificate = nil
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	status := certificateStatus{}
+	if raw, ok := certificate.Object["status"]; ok {
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &status); err != nil {
+			return err
+		}
+	}
+	directus.Status.Certificate = &directusv1.DirectusCertificateStatus{
+		NotAfter:    status.NotAfter,
+		RenewalTime: status.RenewalTime,
+	}
+
+	ready := meta.FindStatusCondition(status.Conditions, "Ready")
+	switch {
+	case ready == nil:
+		setCondition(directus, directusv1.ConditionCertificateReady, metav1.ConditionFalse, "Pending",
+			"Waiting for cert-manager to issue the certificate")
+	case ready.Status != metav1.ConditionTrue:
+		setCondition(directus, directusv1.ConditionCertificateReady, metav1.ConditionFalse, ready.Reason, ready.Message)
+	case status.NotAfter != nil:
+		setCondition(directus, directusv1.ConditionCertificateReady, metav1.ConditionTrue, "Issued",
+			fmt.Sprintf("Certificate is valid until %s", status.NotAfter.UTC().Format(time.RFC3339)))
+	default:
+		setCondition(directus, directusv1.ConditionCertificateReady, metav1.ConditionTrue, "Issued",
+			"Certificate is issued")
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+	"github.com/example/directus-operator/internal/directusapi"
+)
+
+// setCondition sets a status condition, keeping LastTransitionTime unless the status changes
+func setCondition(directus *directusv1.Directus, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&directus.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: directus.Generation,
+	})
+}
+
+// setDeploymentConditions derives Available, Progressing and Degraded from the
+// Deployment. The desired replicas are read from the Deployment, they differ
+// from spec.replicaCount while a restore scales the instance down.
+func setDeploymentConditions(directus *directusv1.Directus, deployment *appsv1.Deployment) {
+	desired := directus.Spec.ReplicaCount
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+
+	if status.ReadyReplicas > 0 {
+		setCondition(directus, directusv1.ConditionAvailable, metav1.ConditionTrue, "MinimumReplicasAvailable",
+			fmt.Sprintf("%d/%d replicas are ready", status.ReadyReplicas, desired))
+	} else {
+		setCondition(directus, directusv1.ConditionAvailable, metav1.ConditionFalse, "NoReplicasAvailable",
+			"No replicas are ready")
+	}
+
+	rolledOut := status.ObservedGeneration >= deployment.Generation &&
+		status.UpdatedReplicas == desired && status.ReadyReplicas == desired
+	if rolledOut {
+		setCondition(directus, directusv1.ConditionProgressing, metav1.ConditionFalse, "RolloutComplete",
+			"All replicas are updated and ready")
+	} else {
+		setCondition(directus, directusv1.ConditionProgressing, metav1.ConditionTrue, "RolloutInProgress",
+			fmt.Sprintf("%d/%d replicas updated, %d ready", status.UpdatedReplicas, desired, status.ReadyReplicas))
+	}
+
+	for _, condition := range status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == "ProgressDeadlineExceeded" {
+			setCondition(directus, directusv1.ConditionDegraded, metav1.ConditionTrue, "ProgressDeadlineExceeded",
+				condition.Message)
+			return
+		}
+		if condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue {
+			setCondition(directus, directusv1.ConditionDegraded, metav1.ConditionTrue, "ReplicaFailure",
+				condition.Message)
+			return
+		}
+	}
+	setCondition(directus, directusv1.ConditionDegraded, metav1.ConditionFalse, "AsExpected",
+		"Deployment is healthy")
+}
+
+// setDependencyConditions derives DatabaseReady and RedisReady. Directus only
+// passes its readiness probe with a working database connection, detailed
+// health checks refine that when the admin credentials are available.
+func setDependencyConditions(directus *directusv1.Directus, health *directusapi.Health) {
+	running := directus.Status.Phase == "Running"
+
+	setHealthCondition(directus, directusv1.ConditionDatabaseReady, running, health, "database")
+
+	if directus.Spec.Redis.Enabled {
+		setHealthCondition(directus, directusv1.ConditionRedisReady, running, health, "redis", "cache", "rateLimiter")
+	} else {
+		meta.RemoveStatusCondition(&directus.Status.Conditions, directusv1.ConditionRedisReady)
+	}
+
+	directus.Status.DatabaseReady = meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionDatabaseReady)
+	directus.Status.RedisReady = meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionRedisReady)
+}
+
+// setHealthCondition sets a condition from the /server/health checks matching the prefixes
+func setHealthCondition(directus *directusv1.Directus, conditionType string, running bool, health *directusapi.Health, prefixes ...string) {
+	if !running {
+		setCondition(directus, conditionType, metav1.ConditionUnknown, "WaitingForInstance",
+			"Waiting for Directus to become ready")
+		return
+	}
+
+	if health != nil {
+		checked := false
+		for name, checks := range health.Checks {
+			if !hasAnyPrefix(name, prefixes) {
+				continue
+			}
+			checked = true
+			for _, check := range checks {
+				if check.Status == "error" {
+					setCondition(directus, conditionType, metav1.ConditionFalse, "HealthCheckFailed",
+						fmt.Sprintf("Health check %s failed", name))
+					return
+				}
+			}
+		}
+		if checked {
+			setCondition(directus, conditionType, metav1.ConditionTrue, "HealthCheckPassed",
+				"Directus health checks passed")
+			return
+		}
+	}
+
+	setCondition(directus, conditionType, metav1.ConditionTrue, "InstanceReady",
+		"Directus replicas passed their readiness probes")
+}
+
+// instanceHealth fetches the detailed health report of a running instance, nil if unavailable
+func (r *DirectusReconciler) instanceHealth(ctx context.Context, directus *directusv1.Directus) *directusapi.Health {
+	if directus.Status.Phase != "Running" {
+		return nil
+	}
+
+	health, err := r.API.adminHealth(ctx, r.Client, directus)
+	if err == nil {
+		return health
+	}
+	logf.FromContext(ctx).Info("Failed to fetch Directus health", "error", err.Error())
+	return nil
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
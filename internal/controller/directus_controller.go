@@ -20,19 +20,27 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	directusv1 "github.com/example/directus-operator/api/v1"
@@ -41,7 +49,17 @@ import (
 // DirectusReconciler reconciles a Directus object
 type DirectusReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	API      DirectusAPI
+	// OpenShiftRoutes is set when the OpenShift Route API was discovered at startup
+	OpenShiftRoutes bool
+	// Certificates is set when the cert-manager Certificate kind was discovered at startup
+	Certificates bool
+	// HTTPRoutes is set when the Gateway API HTTPRoute kind was discovered at startup
+	HTTPRoutes bool
+	// ServiceMonitors is set when the Prometheus Operator ServiceMonitor API was discovered at startup
+	ServiceMonitors bool
 }
 
 // +kubebuilder:rbac:groups=directus.example.com,resources=directuses,verbs=get;list;watch;create;update;patch;delete
@@ -53,7 +71,19 @@ type DirectusReconciler struct {
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=external-secrets.io,resources=externalsecrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=route.openshift.io,resources=routes/custom-host,verbs=create;update
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -72,6 +102,46 @@ func (r *DirectusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	}
 
 	// Apply defaults if not specified
+	applyDefaults(&directus)
+
+	r.setSpecWarnings(&directus, r.validateSpec(ctx, &directus))
+
+	// Create or update resources
+	if err := r.reconcileResources(ctx, &directus); err != nil {
+		return ctrl.Result{}, r.reportReconcileError(ctx, &directus, err)
+	}
+
+	// Update status
+	if err := r.updateStatus(ctx, &directus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	result := ctrl.Result{}
+
+	// TLS secrets, synced application secrets, routes and Certificates are
+	// not owned by the operator, poll until they are ready
+	ingressReady := meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionIngressReady)
+	if (ingressReady != nil && ingressReady.Reason == "TLSSecretMissing") ||
+		meta.IsStatusConditionFalse(directus.Status.Conditions, directusv1.ConditionSecretsReady) ||
+		meta.IsStatusConditionFalse(directus.Status.Conditions, directusv1.ConditionRouteAccepted) ||
+		meta.IsStatusConditionFalse(directus.Status.Conditions, directusv1.ConditionCertificateReady) ||
+		meta.IsStatusConditionFalse(directus.Status.Conditions, directusv1.ConditionRouteAdmitted) {
+		result.RequeueAfter = 30 * time.Second
+	}
+
+	// Come back when the next secret rotation is due
+	if due, ok := secretRotationDue(&directus, time.Now()); ok && directus.Spec.CreateApplicationSecret {
+		after := max(time.Until(due), time.Second)
+		if result.RequeueAfter == 0 || after < result.RequeueAfter {
+			result.RequeueAfter = after
+		}
+	}
+
+	return result, nil
+}
+
+// applyDefaults fills in defaults for fields that are not specified
+func applyDefaults(directus *directusv1.Directus) {
 	if directus.Spec.ReplicaCount == 0 {
 		directus.Spec.ReplicaCount = 1
 	}
@@ -90,46 +160,107 @@ func (r *DirectusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	if directus.Spec.AdminEmail == "" {
 		directus.Spec.AdminEmail = "directus-admin@example.com"
 	}
+}
 
-	// Create or update resources
-	if err := r.reconcileServiceAccount(ctx, &directus); err != nil {
-		return ctrl.Result{}, err
+// reconcileResources creates or updates all child resources
+func (r *DirectusReconciler) reconcileResources(ctx context.Context, directus *directusv1.Directus) error {
+	if err := r.checkReferencedSecrets(ctx, directus); err != nil {
+		return err
 	}
 
-	if err := r.reconcileSecrets(ctx, &directus); err != nil {
-		return ctrl.Result{}, err
+	if err := r.reconcileServiceAccount(ctx, directus); err != nil {
+		return err
 	}
 
-	if err := r.reconcileConfigMap(ctx, &directus); err != nil {
-		return ctrl.Result{}, err
+	if err := r.reconcileSecrets(ctx, directus); err != nil {
+		return err
 	}
 
-	if err := r.reconcileService(ctx, &directus); err != nil {
-		return ctrl.Result{}, err
+	if err := r.reconcileSecretRotation(ctx, directus); err != nil {
+		return err
 	}
 
-	if err := r.reconcileDeployment(ctx, &directus); err != nil {
-		return ctrl.Result{}, err
+	if err := r.reconcileConfigMap(ctx, directus); err != nil {
+		return err
+	}
+
+	if err := r.reconcileService(ctx, directus); err != nil {
+		return err
+	}
+
+	secretsReady, err := r.reconcileSecretsFrom(ctx, directus)
+	if err != nil {
+		return err
+	}
+	if secretsReady {
+		if err := r.reconcileDeployment(ctx, directus); err != nil {
+			return err
+		}
+	}
+
+	if err := r.reconcileOpenShiftRoute(ctx, directus); err != nil {
+		return err
+	}
+
+	if err := r.reconcileCertificate(ctx, directus); err != nil {
+		return err
 	}
 
-	if directus.Spec.Ingress.Enabled {
-		if err := r.reconcileIngress(ctx, &directus); err != nil {
-			return ctrl.Result{}, err
+	if r.ingressEnabled(directus) {
+		if err := r.reconcileIngress(ctx, directus); err != nil {
+			return err
+		}
+	} else if directus.Spec.Ingress.Enabled {
+		// The OpenShift Route replaces the Ingress
+		if err := r.deleteChild(ctx, directus, &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: directus.Name, Namespace: directus.Namespace},
+		}); err != nil {
+			return err
 		}
 	}
 
+	if err := r.reconcileHTTPRoute(ctx, directus); err != nil {
+		return err
+	}
+
 	if directus.Spec.Autoscaling.Enabled {
-		if err := r.reconcileHPA(ctx, &directus); err != nil {
-			return ctrl.Result{}, err
+		if err := r.reconcileHPA(ctx, directus); err != nil {
+			return err
 		}
 	}
 
-	// Update status
-	if err := r.updateStatus(ctx, &directus); err != nil {
-		return ctrl.Result{}, err
+	if err := r.reconcilePodDisruptionBudget(ctx, directus); err != nil {
+		return err
+	}
+
+	if err := r.reconcileNetworkPolicy(ctx, directus); err != nil {
+		return err
+	}
+
+	if err := r.reconcileServiceMonitor(ctx, directus); err != nil {
+		return err
+	}
+
+	if err := r.reconcileBackup(ctx, directus); err != nil {
+		return err
 	}
 
-	return ctrl.Result{}, nil
+	if err := r.reconcileAdminCredentials(ctx, directus); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// reportReconcileError records a failed reconcile in the status and returns err
+func (r *DirectusReconciler) reportReconcileError(ctx context.Context, directus *directusv1.Directus, err error) error {
+	directus.Status.ObservedGeneration = directus.Generation
+	setCondition(directus, directusv1.ConditionReconciled, metav1.ConditionFalse, "ReconcileFailed", err.Error())
+	setCondition(directus, directusv1.ConditionDegraded, metav1.ConditionTrue, "ReconcileFailed", err.Error())
+	if statusErr := r.Status().Update(ctx, directus); statusErr != nil {
+		logf.FromContext(ctx).Error(statusErr, "Failed to update Directus status")
+	}
+	return err
 }
 
 func (r *DirectusReconciler) reconcileServiceAccount(ctx context.Context, directus *directusv1.Directus) error {
@@ -152,21 +283,35 @@ func (r *DirectusReconciler) reconcileServiceAccount(ctx context.Context, direct
 	found := &corev1.ServiceAccount{}
 	err := r.Get(ctx, types.NamespacedName{Name: sa.Name, Namespace: sa.Namespace}, found)
 	if err != nil && errors.IsNotFound(err) {
-		return r.Create(ctx, sa)
+		return r.createChild(ctx, directus, sa)
 	} else if err != nil {
 		return err
 	}
 
 	// Update if needed
+	found.Labels = sa.Labels
 	found.Annotations = sa.Annotations
-	return r.Update(ctx, found)
+	return r.updateChild(ctx, directus, found)
 }
 
 func (r *DirectusReconciler) reconcileSecrets(ctx context.Context, directus *directusv1.Directus) error {
-	if !directus.Spec.CreateApplicationSecret {
+	if !directus.Spec.CreateApplicationSecret || directus.Spec.SecretsFrom != nil {
 		return nil
 	}
 
+	password, err := randomToken(24)
+	if err != nil {
+		return err
+	}
+	key, err := randomToken(32)
+	if err != nil {
+		return err
+	}
+	signingSecret, err := randomToken(32)
+	if err != nil {
+		return err
+	}
+
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      r.getApplicationSecretName(directus),
@@ -174,9 +319,9 @@ func (r *DirectusReconciler) reconcileSecrets(ctx context.Context, directus *dir
 		},
 		Type: corev1.SecretTypeOpaque,
 		Data: map[string][]byte{
-			"ADMIN_PASSWORD": []byte("admin123"),    // In production, generate random password
-			"KEY":            []byte("your-key"),    // In production, generate random key
-			"SECRET":         []byte("your-secret"), // In production, generate random secret
+			"ADMIN_PASSWORD": []byte(password),
+			"KEY":            []byte(key),
+			"SECRET":         []byte(signingSecret),
 		},
 	}
 
@@ -185,9 +330,13 @@ func (r *DirectusReconciler) reconcileSecrets(ctx context.Context, directus *dir
 	}
 
 	found := &corev1.Secret{}
-	err := r.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, found)
+	err = r.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}, found)
 	if err != nil && errors.IsNotFound(err) {
-		return r.Create(ctx, secret)
+		if err := r.createChild(ctx, directus, secret); err != nil {
+			return err
+		}
+		r.recordEvent(directus, corev1.EventTypeNormal, ReasonSecretGenerated, "Generated application Secret %s", secret.Name)
+		return nil
 	} else if err != nil {
 		return err
 	}
@@ -211,36 +360,56 @@ func (r *DirectusReconciler) reconcileConfigMap(ctx context.Context, directus *d
 	found := &corev1.ConfigMap{}
 	err := r.Get(ctx, types.NamespacedName{Name: configMap.Name, Namespace: configMap.Namespace}, found)
 	if err != nil && errors.IsNotFound(err) {
-		return r.Create(ctx, configMap)
+		return r.createChild(ctx, directus, configMap)
 	} else if err != nil {
 		return err
 	}
 
 	// Update data
 	found.Data = configMap.Data
-	return r.Update(ctx, found)
+	return r.updateChild(ctx, directus, found)
 }
 
 func (r *DirectusReconciler) reconcileService(ctx context.Context, directus *directusv1.Directus) error {
+	spec := directus.Spec.Service
 	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      directus.Name,
-			Namespace: directus.Namespace,
+			Name:        directus.Name,
+			Namespace:   directus.Namespace,
+			Labels:      r.getLabels(directus),
+			Annotations: ownAnnotations(spec.Annotations),
 		},
 		Spec: corev1.ServiceSpec{
-			Type: directus.Spec.Service.Type,
+			Type: spec.Type,
 			Ports: []corev1.ServicePort{
 				{
 					Name:       "http",
-					Port:       directus.Spec.Service.Port,
+					Port:       spec.Port,
 					TargetPort: intstr.FromInt(8055),
 					Protocol:   corev1.ProtocolTCP,
 				},
 			},
-			Selector: r.getLabels(directus),
+			Selector:        r.getLabels(directus),
+			SessionAffinity: spec.SessionAffinity,
 		},
 	}
 
+	if spec.Type == corev1.ServiceTypeNodePort || spec.Type == corev1.ServiceTypeLoadBalancer {
+		service.Spec.Ports[0].NodePort = spec.NodePort
+		service.Spec.ExternalTrafficPolicy = spec.ExternalTrafficPolicy
+	}
+	if spec.Type == corev1.ServiceTypeLoadBalancer {
+		service.Spec.LoadBalancerSourceRanges = spec.LoadBalancerSourceRanges
+	}
+	if metricsPort := metricsServicePort(directus); metricsPort != 0 {
+		service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+			Name:       "metrics",
+			Port:       metricsPort,
+			TargetPort: intstr.FromString("http"),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+
 	if err := controllerutil.SetControllerReference(directus, service, r.Scheme); err != nil {
 		return err
 	}
@@ -248,67 +417,93 @@ func (r *DirectusReconciler) reconcileService(ctx context.Context, directus *dir
 	found := &corev1.Service{}
 	err := r.Get(ctx, types.NamespacedName{Name: service.Name, Namespace: service.Namespace}, found)
 	if err != nil && errors.IsNotFound(err) {
-		return r.Create(ctx, service)
+		return r.createChild(ctx, directus, service)
 	} else if err != nil {
 		return err
 	}
+	if found.DeletionTimestamp != nil {
+		return fmt.Errorf("waiting for Service %s to be deleted", found.Name)
+	}
 
 	// Update spec (excluding ClusterIP which is immutable)
-	found.Spec.Type = service.Spec.Type
-	found.Spec.Ports = service.Spec.Ports
-	found.Spec.Selector = service.Spec.Selector
-	return r.Update(ctx, found)
+	typeChanged := found.Spec.Type != service.Spec.Type
+	updateServiceSpec(found, service)
+	err = r.updateChild(ctx, directus, found)
+	if err != nil && typeChanged && errors.IsInvalid(err) {
+		// Some type changes cannot be made in place, the Service is replaced
+		// and created again once deleted
+		return r.deleteChild(ctx, directus, found)
+	}
+	return err
+}
+
+// updateServiceSpec copies the desired spec to the found Service, keeping the
+// allocated node ports and clearing fields the new type does not allow. The
+// annotations of load balancer controllers are kept.
+func updateServiceSpec(found, desired *corev1.Service) {
+	nodePorts := map[string]int32{}
+	if desired.Spec.Type == found.Spec.Type {
+		for _, port := range found.Spec.Ports {
+			nodePorts[port.Name] = port.NodePort
+		}
+	}
+	for i, port := range desired.Spec.Ports {
+		if port.NodePort == 0 && desired.Spec.Type != corev1.ServiceTypeClusterIP {
+			desired.Spec.Ports[i].NodePort = nodePorts[port.Name]
+		}
+	}
+
+	if desired.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		found.Spec.AllocateLoadBalancerNodePorts = nil
+		found.Spec.LoadBalancerClass = nil
+	}
+	if desired.Spec.Type == corev1.ServiceTypeClusterIP {
+		found.Spec.HealthCheckNodePort = 0
+	}
+
+	mergeOwnedMetadata(found, desired)
+	found.Spec.Type = desired.Spec.Type
+	found.Spec.Ports = desired.Spec.Ports
+	found.Spec.Selector = desired.Spec.Selector
+	found.Spec.SessionAffinity = desired.Spec.SessionAffinity
+	found.Spec.ExternalTrafficPolicy = desired.Spec.ExternalTrafficPolicy
+	found.Spec.LoadBalancerSourceRanges = desired.Spec.LoadBalancerSourceRanges
 }
 
 func (r *DirectusReconciler) reconcileDeployment(ctx context.Context, directus *directusv1.Directus) error {
+	template, err := r.buildPodTemplate(directus)
+	if err != nil {
+		return err
+	}
+
 	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      directus.Name,
 			Namespace: directus.Namespace,
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: &directus.Spec.ReplicaCount,
+			Replicas: r.getReplicas(directus),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: r.getLabels(directus),
 			},
-			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels:      r.getLabels(directus),
-					Annotations: directus.Spec.PodAnnotations,
-				},
-				Spec: corev1.PodSpec{
-					ServiceAccountName: r.getServiceAccountName(directus),
-					SecurityContext:    directus.Spec.PodSecurityContext,
-					ImagePullSecrets:   directus.Spec.ImagePullSecrets,
-					InitContainers:     directus.Spec.InitContainers,
-					Containers:         r.buildContainers(directus),
-					Volumes:            directus.Spec.ExtraVolumes,
-					NodeSelector:       directus.Spec.NodeSelector,
-					Tolerations:        directus.Spec.Tolerations,
-					Affinity:           directus.Spec.Affinity,
-				},
-			},
+			Template: *template,
 		},
 	}
 
-	// Add sidecar containers
-	deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, directus.Spec.Sidecars...)
-
 	if err := controllerutil.SetControllerReference(directus, deployment, r.Scheme); err != nil {
 		return err
 	}
 
 	found := &appsv1.Deployment{}
-	err := r.Get(ctx, types.NamespacedName{Name: deployment.Name, Namespace: deployment.Namespace}, found)
+	err = r.Get(ctx, types.NamespacedName{Name: deployment.Name, Namespace: deployment.Namespace}, found)
 	if err != nil && errors.IsNotFound(err) {
-		return r.Create(ctx, deployment)
+		return r.createChild(ctx, directus, deployment)
 	} else if err != nil {
 		return err
 	}
 
 	// Update deployment
-	found.Spec = deployment.Spec
-	return r.Update(ctx, found)
+	return r.updateDeployment(ctx, directus, found, &deployment.Spec)
 }
 
 func (r *DirectusReconciler) reconcileIngress(ctx context.Context, directus *directusv1.Directus) error {
@@ -325,7 +520,7 @@ func (r *DirectusReconciler) reconcileIngress(ctx context.Context, directus *dir
 		},
 		Spec: networkingv1.IngressSpec{
 			IngressClassName: &directus.Spec.Ingress.ClassName,
-			TLS:              directus.Spec.Ingress.TLS,
+			TLS:              r.ingressTLS(directus),
 			Rules:            []networkingv1.IngressRule{},
 		},
 	}
@@ -366,15 +561,16 @@ func (r *DirectusReconciler) reconcileIngress(ctx context.Context, directus *dir
 	found := &networkingv1.Ingress{}
 	err := r.Get(ctx, types.NamespacedName{Name: ingress.Name, Namespace: ingress.Namespace}, found)
 	if err != nil && errors.IsNotFound(err) {
-		return r.Create(ctx, ingress)
+		return r.createChild(ctx, directus, ingress)
 	} else if err != nil {
 		return err
 	}
 
 	// Update ingress
 	found.Spec = ingress.Spec
+	found.Labels = ingress.Labels
 	found.Annotations = ingress.Annotations
-	return r.Update(ctx, found)
+	return r.updateChild(ctx, directus, found)
 }
 
 func (r *DirectusReconciler) reconcileHPA(ctx context.Context, directus *directusv1.Directus) error {
@@ -430,24 +626,35 @@ func (r *DirectusReconciler) reconcileHPA(ctx context.Context, directus *directu
 	found := &autoscalingv2.HorizontalPodAutoscaler{}
 	err := r.Get(ctx, types.NamespacedName{Name: hpa.Name, Namespace: hpa.Namespace}, found)
 	if err != nil && errors.IsNotFound(err) {
-		return r.Create(ctx, hpa)
+		return r.createChild(ctx, directus, hpa)
 	} else if err != nil {
 		return err
 	}
 
 	// Update HPA
 	found.Spec = hpa.Spec
-	return r.Update(ctx, found)
+	return r.updateChild(ctx, directus, found)
 }
 
 func (r *DirectusReconciler) updateStatus(ctx context.Context, directus *directusv1.Directus) error {
+	previousPhase := directus.Status.Phase
+
 	// Get deployment status
 	deployment := &appsv1.Deployment{}
 	err := r.Get(ctx, types.NamespacedName{Name: directus.Name, Namespace: directus.Namespace}, deployment)
-	if err != nil {
+	secretsReady := meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionSecretsReady)
+	if errors.IsNotFound(err) && secretsReady != nil && secretsReady.Status == metav1.ConditionFalse {
+		directus.Status.Phase = "Pending"
+		directus.Status.Message = secretsReady.Message
+		setCondition(directus, directusv1.ConditionAvailable, metav1.ConditionFalse, secretsReady.Reason, secretsReady.Message)
+	} else if err != nil {
 		directus.Status.Phase = "Failed"
 		directus.Status.Message = fmt.Sprintf("Failed to get deployment: %v", err)
+		setCondition(directus, directusv1.ConditionAvailable, metav1.ConditionFalse, "DeploymentUnavailable", directus.Status.Message)
+		setCondition(directus, directusv1.ConditionDegraded, metav1.ConditionTrue, "DeploymentUnavailable", directus.Status.Message)
 	} else {
+		setDeploymentConditions(directus, deployment)
+
 		directus.Status.Replicas = deployment.Status.Replicas
 		directus.Status.ReadyReplicas = deployment.Status.ReadyReplicas
 
@@ -460,28 +667,48 @@ func (r *DirectusReconciler) updateStatus(ctx context.Context, directus *directu
 		}
 	}
 
-	// Update conditions
-	now := metav1.Now()
-	conditions := []metav1.Condition{
-		{
-			Type:               "Ready",
-			Status:             metav1.ConditionUnknown,
-			Reason:             "Reconciling",
-			Message:            "Reconciling Directus resources",
-			LastTransitionTime: now,
-		},
+	if directus.Status.Phase != previousPhase {
+		eventType := corev1.EventTypeNormal
+		if directus.Status.Phase == "Failed" {
+			eventType = corev1.EventTypeWarning
+		}
+		r.recordEvent(directus, eventType, ReasonPhaseChanged, "Phase changed from %q to %q: %s",
+			previousPhase, directus.Status.Phase, directus.Status.Message)
+	}
+
+	// Drop the single condition reported by earlier operator versions
+	meta.RemoveStatusCondition(&directus.Status.Conditions, "Ready")
+
+	directus.Status.ObservedGeneration = directus.Generation
+	setCondition(directus, directusv1.ConditionReconciled, metav1.ConditionTrue, "ReconcileSucceeded",
+		"All resources are up to date")
+
+	// Detailed checks need a running instance
+	health := r.instanceHealth(ctx, directus)
+	setDependencyConditions(directus, health)
+
+	if err := r.setIngressStatus(ctx, directus); err != nil {
+		return err
+	}
+	directus.Status.IngressReady = meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionIngressReady)
+
+	if err := r.setOpenShiftRouteStatus(ctx, directus); err != nil {
+		return err
 	}
 
-	if directus.Status.Phase == "Running" {
-		conditions[0].Status = metav1.ConditionTrue
-		conditions[0].Reason = "Ready"
-		conditions[0].Message = "Directus is running"
+	if err := r.setCertificateStatus(ctx, directus); err != nil {
+		return err
+	}
+
+	if err := r.setRouteStatus(ctx, directus); err != nil {
+		return err
 	}
 
-	directus.Status.Conditions = conditions
-	directus.Status.DatabaseReady = true // Simplified for now
-	directus.Status.RedisReady = directus.Spec.Redis.Enabled
-	directus.Status.IngressReady = directus.Spec.Ingress.Enabled
+	setEmailCondition(directus, health)
+
+	if err := r.setBackupStatus(ctx, directus); err != nil {
+		return err
+	}
 
 	return r.Status().Update(ctx, directus)
 }
@@ -497,6 +724,27 @@ func (r *DirectusReconciler) getLabels(directus *directusv1.Directus) map[string
 	}
 }
 
+// getReplicas returns the Deployment replicas, zero while a restore is in progress
+func (r *DirectusReconciler) getReplicas(directus *directusv1.Directus) *int32 {
+	if _, ok := directus.Annotations[directusv1.RestoreInProgressAnnotation]; ok {
+		return ptr.To[int32](0)
+	}
+	return &directus.Spec.ReplicaCount
+}
+
+// removeAnnotation removes an annotation from the Directus resource. Only the
+// annotation is patched, the in-memory spec holds applied defaults.
+func (r *DirectusReconciler) removeAnnotation(ctx context.Context, directus *directusv1.Directus, key string) error {
+	patched := directus.DeepCopy()
+	delete(patched.Annotations, key)
+	if err := r.Patch(ctx, patched, client.MergeFrom(directus)); err != nil {
+		return err
+	}
+	delete(directus.Annotations, key)
+	directus.ResourceVersion = patched.ResourceVersion
+	return nil
+}
+
 func (r *DirectusReconciler) getServiceAccountName(directus *directusv1.Directus) string {
 	if directus.Spec.ServiceAccount.Name != "" {
 		return directus.Spec.ServiceAccount.Name
@@ -505,10 +753,7 @@ func (r *DirectusReconciler) getServiceAccountName(directus *directusv1.Directus
 }
 
 func (r *DirectusReconciler) getApplicationSecretName(directus *directusv1.Directus) string {
-	if directus.Spec.ApplicationSecretName != "" {
-		return directus.Spec.ApplicationSecretName
-	}
-	return directus.Name + "-application-secret"
+	return applicationSecretName(directus)
 }
 
 func (r *DirectusReconciler) buildConfigMapData(directus *directusv1.Directus) map[string]string {
@@ -533,6 +778,10 @@ func (r *DirectusReconciler) buildConfigMapData(directus *directusv1.Directus) m
 		data["DB_USER"] = directus.Spec.Database.Username
 	}
 
+	if url := r.publicURL(directus); url != "" {
+		data["PUBLIC_URL"] = url
+	}
+
 	// Redis configuration
 	data["REDIS_ENABLED"] = strconv.FormatBool(directus.Spec.Redis.Enabled)
 	if directus.Spec.Redis.Enabled {
@@ -544,6 +793,15 @@ func (r *DirectusReconciler) buildConfigMapData(directus *directusv1.Directus) m
 		}
 	}
 
+	// Cache, rate limiter and synchronization stores
+	r.buildStoreConfig(directus, data)
+
+	// Email configuration
+	r.buildEmailConfig(directus, data)
+
+	// Prometheus metrics
+	r.buildMetricsConfig(directus, data)
+
 	return data
 }
 
@@ -600,8 +858,34 @@ func (r *DirectusReconciler) buildContainers(directus *directusv1.Directus) []co
 		})
 	}
 
-	// Add application secret if created
-	if directus.Spec.CreateApplicationSecret {
+	// Add Redis password if using a secured Redis
+	if directus.Spec.Redis.Enabled && directus.Spec.Redis.ExistingSecret != "" {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name: "REDIS_PASSWORD",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: directus.Spec.Redis.ExistingSecret,
+					},
+					Key: "password",
+				},
+			},
+		})
+	}
+
+	// Add email transport credentials
+	container.Env = append(container.Env, r.buildEmailEnvVars(directus)...)
+
+	// Add the token scrapers read the metrics with
+	container.Env = append(container.Env, r.buildMetricsEnvVars(directus)...)
+
+	// Add credentials injected by the Vault Agent
+	if from := directus.Spec.SecretsFrom; from != nil && from.Vault != nil {
+		container.Env = append(container.Env, vaultEnvVars(from.Vault)...)
+	}
+
+	// Add application secret if created or synced from an external store
+	if usesApplicationSecret(directus) {
 		container.EnvFrom = append(container.EnvFrom, corev1.EnvFromSource{
 			SecretRef: &corev1.SecretEnvSource{
 				LocalObjectReference: corev1.LocalObjectReference{
@@ -662,7 +946,7 @@ func (r *DirectusReconciler) buildContainers(directus *directusv1.Directus) []co
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DirectusReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&directusv1.Directus{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
@@ -671,6 +955,31 @@ func (r *DirectusReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&networkingv1.Ingress{}).
 		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
-		Named("directus").
-		Complete(r)
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Owns(&batchv1.CronJob{}).
+		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(backupJobToDirectus))
+
+	if r.Certificates {
+		certificate := &unstructured.Unstructured{}
+		certificate.SetGroupVersionKind(certificateGVK)
+		builder = builder.Owns(certificate)
+	}
+	if r.HTTPRoutes {
+		route := &unstructured.Unstructured{}
+		route.SetGroupVersionKind(httpRouteGVK)
+		builder = builder.Owns(route)
+	}
+	if r.OpenShiftRoutes {
+		route := &unstructured.Unstructured{}
+		route.SetGroupVersionKind(openShiftRouteGVK)
+		builder = builder.Owns(route)
+	}
+	if r.ServiceMonitors {
+		monitor := &unstructured.Unstructured{}
+		monitor.SetGroupVersionKind(serviceMonitorGVK)
+		builder = builder.Owns(monitor)
+	}
+
+	return builder.Named("directus").Complete(r)
 }
@@ -18,11 +18,26 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -81,4 +96,1960 @@ var _ = Describe("Directus Controller", func() {
 			// Example: If you expect a certain status condition after reconciliation, verify it here.
 		})
 	})
+
+	Context("When email transport is configured", func() {
+		const resourceName = "email-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating a Directus with an SMTP transport")
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					CreateApplicationSecret: true,
+					Email: &directusv1.DirectusEmail{
+						Transport: "smtp",
+						From:      "no-reply@example.com",
+						SMTP: directusv1.DirectusEmailSMTP{
+							Host: "mailhog",
+							Port: 1025,
+						},
+						ExistingSecret: "smtp-credentials",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should render EMAIL_* variables and report transport health", func() {
+			logins := 0
+			health := `{"status":"ok","checks":{"email:connection":[{"status":"ok"}]}}`
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /auth/login", func(w http.ResponseWriter, r *http.Request) {
+				logins++
+				_, _ = w.Write([]byte(`{"data":{"access_token":"token"}}`))
+			})
+			mux.HandleFunc("GET /server/health", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(health))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("checking the ConfigMap")
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-configmap", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("EMAIL_TRANSPORT", "smtp"))
+			Expect(configMap.Data).To(HaveKeyWithValue("EMAIL_FROM", "no-reply@example.com"))
+			Expect(configMap.Data).To(HaveKeyWithValue("EMAIL_SMTP_HOST", "mailhog"))
+			Expect(configMap.Data).To(HaveKeyWithValue("EMAIL_SMTP_PORT", "1025"))
+
+			By("checking the password is read from the secret")
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+			env := deployment.Spec.Template.Spec.Containers[0].Env
+			Expect(env).To(ContainElement(HaveField("Name", "EMAIL_SMTP_PASSWORD")))
+
+			By("marking the deployment ready and reconciling again")
+			deployment.Status.Replicas = 1
+			deployment.Status.ReadyReplicas = 1
+			Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(directus.Status.EmailReady).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionEmailReady)).To(BeTrue())
+
+			By("reusing the admin token for later health checks")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logins).To(Equal(1))
+
+			By("reporting an unknown transport health when the check is missing")
+			health = `{"status":"ok","checks":{"pg:responseTime":[{"status":"ok"}]}}`
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(directus.Status.EmailReady).To(BeFalse())
+			condition := meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionEmailReady)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+			Expect(condition.Reason).To(Equal("HealthCheckMissing"))
+		})
+	})
+
+	Context("When running multiple replicas with Redis", func() {
+		const resourceName = "redis-stores-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should back all stores with Redis", func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					ReplicaCount: 2,
+					Redis: directusv1.DirectusRedis{
+						Enabled: true,
+						Host:    "redis",
+						Port:    6379,
+					},
+					Cache: directusv1.DirectusCache{
+						Enabled:   true,
+						TTL:       "10m",
+						AutoPurge: true,
+						Namespace: "tenant-a",
+					},
+					RateLimiter: directusv1.DirectusRateLimiter{
+						Enabled:  true,
+						Points:   50,
+						Duration: 1,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			Expect(controllerReconciler.validateSpec(ctx, resource)).To(BeEmpty())
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-configmap", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("CACHE_STORE", "redis"))
+			Expect(configMap.Data).To(HaveKeyWithValue("CACHE_TTL", "10m"))
+			Expect(configMap.Data).To(HaveKeyWithValue("CACHE_AUTO_PURGE", "true"))
+			Expect(configMap.Data).To(HaveKeyWithValue("CACHE_NAMESPACE", "tenant-a"))
+			Expect(configMap.Data).To(HaveKeyWithValue("RATE_LIMITER_STORE", "redis"))
+			Expect(configMap.Data).To(HaveKeyWithValue("RATE_LIMITER_POINTS", "50"))
+			Expect(configMap.Data).To(HaveKeyWithValue("SESSION_STORE", "redis"))
+			Expect(configMap.Data).To(HaveKeyWithValue("SYNCHRONIZATION_STORE", "redis"))
+			Expect(configMap.Data).To(HaveKeyWithValue("MESSENGER_STORE", "redis"))
+		})
+
+		It("should warn when replicas do not share a Redis", func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					ReplicaCount: 3,
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+
+			controllerReconciler := &DirectusReconciler{}
+			Expect(controllerReconciler.validateSpec(ctx, resource)).To(ContainElement(ContainSubstring("without a shared Redis")))
+
+			By("reporting the warning as a condition and recording it once")
+			recorder := record.NewFakeRecorder(10)
+			controllerReconciler.Recorder = recorder
+			warnings := controllerReconciler.validateSpec(ctx, resource)
+			controllerReconciler.setSpecWarnings(resource, warnings)
+			controllerReconciler.setSpecWarnings(resource, warnings)
+			condition := meta.FindStatusCondition(resource.Status.Conditions, directusv1.ConditionSpecWarnings)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring("without a shared Redis"))
+			Expect(recorder.Events).To(HaveLen(1))
+
+			By("clearing the condition once the warning is resolved")
+			controllerReconciler.setSpecWarnings(resource, nil)
+			Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, directusv1.ConditionSpecWarnings)).To(BeTrue())
+		})
+	})
+
+	Context("When recording events", func() {
+		const resourceName = "events-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					CreateApplicationSecret: true,
+					Database: directusv1.DirectusDatabase{
+						Engine:         "postgres",
+						ExistingSecret: "missing-db-credentials",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should record creation, secret and phase events", func() {
+			recorder := record.NewFakeRecorder(50)
+			controllerReconciler := &DirectusReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			events := []string{}
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			Expect(events).To(ContainElement("Warning SecretNotFound Referenced Secret missing-db-credentials not found"))
+			Expect(events).To(ContainElement(HavePrefix("Normal SecretGenerated")))
+			Expect(events).To(ContainElement("Normal Created Created Deployment " + resourceName))
+			Expect(events).To(ContainElement(HavePrefix("Normal PhaseChanged")))
+
+			By("reconciling again without changes")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			for len(recorder.Events) > 0 {
+				Expect(<-recorder.Events).NotTo(HavePrefix("Normal Updated"))
+			}
+		})
+	})
+
+	Context("When reporting conditions", func() {
+		const resourceName = "conditions-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should track rollout progress and keep transition times", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(directus.Status.ObservedGeneration).To(Equal(directus.Generation))
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionReconciled)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(directus.Status.Conditions, directusv1.ConditionAvailable)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionProgressing)).To(BeTrue())
+			Expect(meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionRedisReady)).To(BeNil())
+			reconciled := meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionReconciled)
+
+			By("marking the deployment rolled out")
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+			deployment.Status.ObservedGeneration = deployment.Generation
+			deployment.Status.Replicas = 1
+			deployment.Status.UpdatedReplicas = 1
+			deployment.Status.ReadyReplicas = 1
+			Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionAvailable)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(directus.Status.Conditions, directusv1.ConditionProgressing)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(directus.Status.Conditions, directusv1.ConditionDegraded)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionDatabaseReady)).To(BeTrue())
+			Expect(meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionReconciled).LastTransitionTime).
+				To(Equal(reconciled.LastTransitionTime))
+		})
+
+		It("should compare against the replicas of the Deployment", func() {
+			directus := &directusv1.Directus{Spec: directusv1.DirectusSpec{ReplicaCount: 1}}
+			deployment := &appsv1.Deployment{
+				Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](3)},
+				Status: appsv1.DeploymentStatus{
+					Replicas:        3,
+					UpdatedReplicas: 3,
+					ReadyReplicas:   3,
+				},
+			}
+			setDeploymentConditions(directus, deployment)
+			Expect(meta.IsStatusConditionFalse(directus.Status.Conditions, directusv1.ConditionProgressing)).To(BeTrue())
+			Expect(meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionAvailable).Message).
+				To(Equal("3/3 replicas are ready"))
+		})
+	})
+
+	Context("When an ingress is enabled", func() {
+		const resourceName = "ingress-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					Ingress: directusv1.DirectusIngress{
+						Enabled:   true,
+						ClassName: "nginx",
+						Hosts: []directusv1.DirectusIngressHost{{
+							Host:  "directus.example.com",
+							Paths: []directusv1.DirectusIngressPath{{Path: "/", PathType: "Prefix"}},
+						}},
+						TLS: []networkingv1.IngressTLS{{
+							SecretName: "ingress-resource-tls",
+							Hosts:      []string{"directus.example.com"},
+						}},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should only report IngressReady after admission with TLS secrets present", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).NotTo(BeZero())
+
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			condition := meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionIngressReady)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal("TLSSecretMissing"))
+
+			By("creating the TLS secret")
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ingress-resource-tls", Namespace: "default"},
+				Type:       corev1.SecretTypeOpaque,
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, secret)
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			condition = meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionIngressReady)
+			Expect(condition.Reason).To(Equal("PendingAdmission"))
+
+			By("admitting the ingress")
+			ingress := &networkingv1.Ingress{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, ingress)).To(Succeed())
+			ingress.Status.LoadBalancer.Ingress = []networkingv1.IngressLoadBalancerIngress{{IP: "203.0.113.10"}}
+			Expect(k8sClient.Status().Update(ctx, ingress)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionIngressReady)).To(BeTrue())
+			Expect(directus.Status.IngressReady).To(BeTrue())
+			Expect(directus.Status.Ingress.Addresses).To(ConsistOf("203.0.113.10"))
+			Expect(directus.Status.Ingress.Hosts).To(ConsistOf("directus.example.com"))
+		})
+	})
+
+	Context("When scheduled backups are enabled", func() {
+		const resourceName = "backup-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					Database: directusv1.DirectusDatabase{
+						Engine:         "postgresql",
+						Host:           "postgresql",
+						Database:       "directus",
+						Username:       "directus",
+						ExistingSecret: "postgresql-credentials",
+					},
+					Backup: &directusv1.DirectusBackupSchedule{
+						Enabled:  true,
+						Schedule: "0 2 * * *",
+						Storage: directusv1.DirectusBackupStorage{
+							S3: &directusv1.DirectusBackupS3{
+								Bucket:         "backups",
+								Prefix:         "directus",
+								Endpoint:       "http://minio:9000",
+								ExistingSecret: "s3-credentials",
+							},
+						},
+						Retention: directusv1.DirectusBackupRetention{KeepLast: 7, MaxAgeDays: 30},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should create and remove the backup CronJob", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			cronJob := &batchv1.CronJob{}
+			cronJobName := types.NamespacedName{Name: resourceName + "-backup", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, cronJobName, cronJob)).To(Succeed())
+			Expect(cronJob.Spec.Schedule).To(Equal("0 2 * * *"))
+			podSpec := cronJob.Spec.JobTemplate.Spec.Template.Spec
+			Expect(podSpec.InitContainers[0].Env).To(ContainElement(corev1.EnvVar{Name: "DUMP_TOOL", Value: "pg_dump"}))
+			Expect(podSpec.InitContainers[0].Env).To(ContainElement(HaveField("Name", "DB_PASSWORD")))
+			Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "S3_PREFIX", Value: "directus/" + resourceName}))
+			Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "KEEP_LAST", Value: "7"}))
+			Expect(cronJob.Spec.JobTemplate.Spec.Template.Labels).To(HaveKeyWithValue("app.kubernetes.io/component", "backup"))
+
+			By("disabling backups")
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			directus.Spec.Backup.Enabled = false
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, cronJobName, cronJob)
+			Expect(errors.IsNotFound(err) || cronJob.DeletionTimestamp != nil).To(BeTrue())
+		})
+	})
+
+	Context("When the admin credentials change", func() {
+		const resourceName = "admin-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		secretName := types.NamespacedName{Name: resourceName + "-application-secret", Namespace: "default"}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					CreateApplicationSecret: true,
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should apply changed and rotated passwords through the API", func() {
+			var updates []map[string]any
+			// The instance was bootstrapped with the first generated password
+			current := ""
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /auth/login", func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]string
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				if body["password"] != current {
+					w.WriteHeader(http.StatusUnauthorized)
+					_, _ = w.Write([]byte(`{"errors":[{"message":"Invalid user credentials."}]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"data":{"access_token":"token"}}`))
+			})
+			mux.HandleFunc("PATCH /users/me", func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]any
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				updates = append(updates, body)
+				current = body["password"].(string)
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			controllerReconciler := &DirectusReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(50),
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+			// The instance never becomes ready in envtest, report it as running
+			reconcileRunning := func() {
+				directus := &directusv1.Directus{}
+				Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+				directus.Status.Phase = "Running"
+				Expect(k8sClient.Status().Update(ctx, directus)).To(Succeed())
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, secretName, secret)).To(Succeed())
+			password := string(secret.Data["ADMIN_PASSWORD"])
+			Expect(password).To(HaveLen(32))
+			current = password
+
+			By("recording the bootstrap credentials")
+			reconcileRunning()
+			credentials := &corev1.Secret{}
+			credentialsName := types.NamespacedName{Name: resourceName + "-admin-credentials", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, credentialsName, credentials)).To(Succeed())
+			Expect(string(credentials.Data["ADMIN_PASSWORD"])).To(Equal(password))
+			Expect(updates).To(BeEmpty())
+
+			By("changing the password in the application secret")
+			secret.Data["ADMIN_PASSWORD"] = []byte("changed-password")
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+			reconcileRunning()
+			Expect(updates).To(ConsistOf(HaveKeyWithValue("password", "changed-password")))
+
+			By("rotating the password")
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			directus.Annotations = map[string]string{directusv1.RotateAdminPasswordAnnotation: "true"}
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+			reconcileRunning()
+
+			Expect(k8sClient.Get(ctx, secretName, secret)).To(Succeed())
+			Expect(string(secret.Data["ADMIN_PASSWORD"])).NotTo(Equal("changed-password"))
+			Expect(updates).To(HaveLen(2))
+			Expect(updates[1]).To(HaveKeyWithValue("password", string(secret.Data["ADMIN_PASSWORD"])))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(directus.Annotations).NotTo(HaveKey(directusv1.RotateAdminPasswordAnnotation))
+
+			By("recovering from a stale record")
+			Expect(k8sClient.Get(ctx, credentialsName, credentials)).To(Succeed())
+			credentials.Data["ADMIN_PASSWORD"] = []byte("stale-password")
+			Expect(k8sClient.Update(ctx, credentials)).To(Succeed())
+			reconcileRunning()
+			Expect(updates).To(HaveLen(2))
+			Expect(k8sClient.Get(ctx, credentialsName, credentials)).To(Succeed())
+			Expect(string(credentials.Data["ADMIN_PASSWORD"])).To(Equal(current))
+		})
+
+		It("should not record credentials the instance rejects", func() {
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /auth/login", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"errors":[{"message":"Invalid user credentials."}]}`))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			controllerReconciler := &DirectusReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(50),
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			directus.Status.Phase = "Running"
+			Expect(k8sClient.Status().Update(ctx, directus)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(ContainSubstring("none were recorded")))
+
+			credentials := &corev1.Secret{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-admin-credentials", Namespace: "default"}, credentials)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When rotating KEY and SECRET", func() {
+		const resourceName = "rotation-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        resourceName,
+					Namespace:   "default",
+					Annotations: map[string]string{directusv1.RotateSecretsAnnotation: "true"},
+				},
+				Spec: directusv1.DirectusSpec{
+					CreateApplicationSecret: true,
+					SecretRotation: &directusv1.DirectusSecretRotation{
+						Interval: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should rotate on request and restart the pods", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(50),
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", 30*24*time.Hour, time.Minute))
+
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(directus.Annotations).NotTo(HaveKey(directusv1.RotateSecretsAnnotation))
+			Expect(directus.Status.SecretRotationTime).NotTo(BeNil())
+			Expect(directus.Spec.ReplicaCount).To(BeZero(), "defaults must not be persisted")
+
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-application-secret", Namespace: "default"}, secret)).To(Succeed())
+			Expect(secret.Data["SECRET"]).To(HaveLen(43))
+			Expect(secret.Data["KEY"]).NotTo(Equal(secret.Data["SECRET"]))
+
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+			rotatedAt := directus.Status.SecretRotationTime.UTC().Format(time.RFC3339)
+			Expect(deployment.Spec.Template.Annotations).To(HaveKeyWithValue(directusv1.SecretsRotatedAtAnnotation, rotatedAt))
+			Expect(secret.Annotations).To(HaveKeyWithValue(directusv1.SecretsRotatedAtAnnotation, rotatedAt))
+
+			By("completing a rotation the Secret does not hold yet without rotating again")
+			rotatedKey := secret.Data["KEY"]
+			delete(secret.Annotations, directusv1.SecretsRotatedAtAnnotation)
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(directus.Status.SecretRotationTime.UTC().Format(time.RFC3339)).To(Equal(rotatedAt))
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-application-secret", Namespace: "default"}, secret)).To(Succeed())
+			Expect(secret.Data["KEY"]).NotTo(Equal(rotatedKey))
+			Expect(secret.Annotations).To(HaveKeyWithValue(directusv1.SecretsRotatedAtAnnotation, rotatedAt))
+		})
+
+		It("should only rotate inside the maintenance window", func() {
+			window := &directusv1.DirectusMaintenanceWindow{
+				Days:     []string{"Sat", "Sun"},
+				Start:    "23:00",
+				Duration: metav1.Duration{Duration: 3 * time.Hour},
+			}
+			// 2025-06-04 is a Wednesday
+			wednesday := time.Date(2025, 6, 4, 12, 0, 0, 0, time.UTC)
+			Expect(maintenanceWindowStart(window, wednesday)).To(Equal(time.Date(2025, 6, 7, 23, 0, 0, 0, time.UTC)))
+			// Early Monday is still inside the window opened on Sunday
+			sundayNight := time.Date(2025, 6, 9, 1, 30, 0, 0, time.UTC)
+			Expect(maintenanceWindowStart(window, sundayNight)).To(Equal(sundayNight))
+			mondayNight := time.Date(2025, 6, 10, 1, 30, 0, 0, time.UTC)
+			Expect(maintenanceWindowStart(window, mondayNight)).To(Equal(time.Date(2025, 6, 14, 23, 0, 0, 0, time.UTC)))
+			Expect(maintenanceWindowStart(nil, wednesday)).To(Equal(wednesday))
+		})
+	})
+
+	Context("When credentials come from an external store", func() {
+		const resourceName = "external-secrets-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		secretName := types.NamespacedName{Name: resourceName + "-application-secret", Namespace: "default"}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					SecretsFrom: &directusv1.DirectusSecretsFrom{
+						ExternalSecret: &directusv1.DirectusExternalSecret{
+							SecretStoreRef: directusv1.DirectusSecretStoreRef{Name: "vault", Kind: "ClusterSecretStore"},
+							Data: []directusv1.DirectusExternalSecretData{
+								{SecretKey: "ADMIN_PASSWORD", RemoteKey: "directus/admin", Property: "password"},
+							},
+							DataFrom: []string{"directus/keys"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			secret := &corev1.Secret{}
+			if err := k8sClient.Get(ctx, secretName, secret); err == nil {
+				Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+			}
+		})
+
+		It("should create an ExternalSecret and wait for its Secret", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(50),
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+
+			externalSecret := &unstructured.Unstructured{}
+			externalSecret.SetGroupVersionKind(externalSecretGVK)
+			Expect(k8sClient.Get(ctx, secretName, externalSecret)).To(Succeed())
+			Expect(externalSecret.Object).To(HaveKeyWithValue("spec", And(
+				HaveKeyWithValue("secretStoreRef", HaveKeyWithValue("kind", "ClusterSecretStore")),
+				HaveKeyWithValue("target", HaveKeyWithValue("name", secretName.Name)),
+				HaveKeyWithValue("data", ConsistOf(HaveKeyWithValue("remoteRef", HaveKeyWithValue("property", "password")))),
+			)))
+
+			deployment := &appsv1.Deployment{}
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, deployment))).To(BeTrue())
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(directus.Status.Phase).To(Equal("Pending"))
+			Expect(meta.IsStatusConditionFalse(directus.Status.Conditions, directusv1.ConditionSecretsReady)).To(BeTrue())
+
+			By("syncing the Secret as the External Secrets Operator would")
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretName.Name, Namespace: "default"},
+				StringData: map[string]string{"ADMIN_PASSWORD": "from-store", "KEY": "key", "SECRET": "secret"},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].EnvFrom).To(ContainElement(
+				HaveField("SecretRef.LocalObjectReference.Name", secretName.Name)))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionSecretsReady)).To(BeTrue())
+		})
+
+		It("should configure Vault Agent injection", func() {
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			directus.Spec.SecretsFrom = &directusv1.DirectusSecretsFrom{
+				Vault: &directusv1.DirectusVaultSecrets{Role: "directus", Path: "secret/data/directus"},
+			}
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+
+			controllerReconciler := &DirectusReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(50),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+			template := deployment.Spec.Template
+			Expect(template.Annotations).To(HaveKeyWithValue("vault.hashicorp.com/role", "directus"))
+			Expect(template.Annotations).To(HaveKeyWithValue("vault.hashicorp.com/agent-inject-template-SECRET",
+				`{{- with secret "secret/data/directus" -}}{{ .Data.data.SECRET }}{{- end -}}`))
+			Expect(template.Spec.Containers[0].Env).To(ContainElement(
+				corev1.EnvVar{Name: "ADMIN_PASSWORD_FILE", Value: "/vault/secrets/ADMIN_PASSWORD"}))
+			Expect(template.Spec.Containers[0].EnvFrom).NotTo(ContainElement(HaveField("SecretRef", Not(BeNil()))))
+		})
+
+		It("should not reference the application secret with Vault and createApplicationSecret", func() {
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			directus.Spec.CreateApplicationSecret = true
+			directus.Spec.SecretsFrom = &directusv1.DirectusSecretsFrom{
+				Vault: &directusv1.DirectusVaultSecrets{Role: "directus", Path: "secret/data/directus"},
+			}
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+
+			controllerReconciler := &DirectusReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(50),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, secretName, &corev1.Secret{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].EnvFrom).NotTo(ContainElement(
+				HaveField("SecretRef.LocalObjectReference.Name", secretName.Name)))
+		})
+	})
+
+	Context("When running multiple replicas", func() {
+		const resourceName = "pdb-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					ReplicaCount: 3,
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should manage the PodDisruptionBudget", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			pdb := &policyv1.PodDisruptionBudget{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, pdb)).To(Succeed())
+			Expect(pdb.Spec.MaxUnavailable).To(HaveValue(Equal(intstr.FromInt32(1))))
+			Expect(pdb.Spec.Selector.MatchLabels).To(HaveKeyWithValue("app.kubernetes.io/instance", resourceName))
+
+			By("configuring minAvailable")
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			directus.Spec.PodDisruptionBudget = &directusv1.DirectusPodDisruptionBudget{
+				MinAvailable: ptr.To(intstr.FromString("50%")),
+			}
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, pdb)).To(Succeed())
+			Expect(pdb.Spec.MinAvailable).To(HaveValue(Equal(intstr.FromString("50%"))))
+			Expect(pdb.Spec.MaxUnavailable).To(BeNil())
+
+			By("scaling down to a single replica")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			directus.Spec.ReplicaCount = 1
+			directus.Spec.PodDisruptionBudget = nil
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, typeNamespacedName, pdb)
+			Expect(errors.IsNotFound(err) || pdb.DeletionTimestamp != nil).To(BeTrue())
+		})
+	})
+
+	Context("When a NetworkPolicy is enabled", func() {
+		const resourceName = "netpol-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "netpol-postgres",
+					Namespace: "default",
+				},
+				Spec: corev1.ServiceSpec{
+					Selector: map[string]string{"app": "postgres"},
+					Ports: []corev1.ServicePort{{
+						Port:       5432,
+						TargetPort: intstr.FromString("postgresql"),
+					}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, service)).To(Succeed())
+
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					Database: directusv1.DirectusDatabase{
+						Engine: "postgres",
+						Host:   "netpol-postgres.default.svc.cluster.local",
+					},
+					Redis: directusv1.DirectusRedis{
+						Enabled: true,
+						Host:    "10.0.0.5",
+					},
+					Email: &directusv1.DirectusEmail{
+						Transport: "smtp",
+						SMTP:      directusv1.DirectusEmailSMTP{Host: "10.0.0.25"},
+					},
+					NetworkPolicy: &directusv1.DirectusNetworkPolicy{
+						Enabled:           true,
+						AllowedNamespaces: []string{"monitoring"},
+						EgressCIDRs:       []string{"192.168.0.0/16"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "netpol-postgres", Namespace: "default"}, service)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, service)).To(Succeed())
+		})
+
+		It("should restrict ingress and derive egress from the database, Redis and SMTP", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			policy := &networkingv1.NetworkPolicy{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, policy)).To(Succeed())
+			Expect(policy.Spec.PodSelector.MatchLabels).To(HaveKeyWithValue("app.kubernetes.io/instance", resourceName))
+			Expect(policy.Spec.Ingress).To(HaveLen(1))
+			Expect(policy.Spec.Ingress[0].From).To(ContainElement(namespacePeer("monitoring")))
+			Expect(policy.Spec.Ingress[0].From).NotTo(ContainElement(namespacePeer("ingress-nginx")))
+
+			// DNS, database, Redis, SMTP and the CIDRs
+			Expect(policy.Spec.Egress).To(HaveLen(5))
+			database := policy.Spec.Egress[1]
+			Expect(database.To[0].PodSelector.MatchLabels).To(Equal(map[string]string{"app": "postgres"}))
+			Expect(database.Ports[0].Port).To(HaveValue(Equal(intstr.FromString("postgresql"))))
+			redis := policy.Spec.Egress[2]
+			Expect(redis.To[0].IPBlock.CIDR).To(Equal("10.0.0.5/32"))
+			Expect(redis.Ports[0].Port).To(HaveValue(Equal(intstr.FromInt32(6379))))
+			smtp := policy.Spec.Egress[3]
+			Expect(smtp.To[0].IPBlock.CIDR).To(Equal("10.0.0.25/32"))
+			Expect(smtp.Ports[0].Port).To(HaveValue(Equal(intstr.FromInt32(587))))
+			Expect(policy.Spec.Egress[4].To[0].IPBlock.CIDR).To(Equal("192.168.0.0/16"))
+
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(controllerReconciler.validateSpec(ctx, directus)).NotTo(ContainElement(ContainSubstring("cannot derive egress")))
+
+			By("using an SMTP server outside the cluster")
+			directus.Spec.Email.SMTP.Host = "smtp.example.com"
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+			Expect(controllerReconciler.validateSpec(ctx, directus)).To(ContainElement(
+				"networkPolicy cannot derive egress for SMTP host smtp.example.com, add its CIDR to networkPolicy.egressCIDRs"))
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, policy)).To(Succeed())
+			Expect(policy.Spec.Egress).To(HaveLen(4))
+
+			By("disabling the NetworkPolicy")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			directus.Spec.NetworkPolicy.Enabled = false
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, typeNamespacedName, policy)
+			Expect(errors.IsNotFound(err) || policy.DeletionTimestamp != nil).To(BeTrue())
+		})
+
+		It("should allow the Gateway data plane", func() {
+			controllerReconciler := &DirectusReconciler{HTTPRoutes: true}
+			directus := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: directusv1.DirectusSpec{
+					Gateway: &directusv1.DirectusGateway{
+						Enabled: true,
+						ParentRefs: []directusv1.DirectusGatewayParentRef{
+							{Name: "public"}, {Name: "internal"}, {Name: "shared", Namespace: "gateways"},
+						},
+					},
+					NetworkPolicy: &directusv1.DirectusNetworkPolicy{Enabled: true},
+				},
+			}
+			from := controllerReconciler.buildIngressRule(directus).From
+			Expect(from).To(ContainElements(namespacePeer("default"), namespacePeer("gateways")))
+			Expect(from).To(HaveLen(3))
+
+			By("listing the data plane namespaces")
+			directus.Spec.NetworkPolicy.GatewayNamespaces = []string{"envoy-gateway-system"}
+			from = controllerReconciler.buildIngressRule(directus).From
+			Expect(from).To(ContainElement(namespacePeer("envoy-gateway-system")))
+			Expect(from).NotTo(ContainElement(namespacePeer("gateways")))
+
+			By("without the Gateway API")
+			controllerReconciler.HTTPRoutes = false
+			Expect(controllerReconciler.buildIngressRule(directus).From).NotTo(ContainElement(namespacePeer("envoy-gateway-system")))
+		})
+
+		It("should allow Prometheus to scrape the metrics", func() {
+			controllerReconciler := &DirectusReconciler{}
+			directus := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName, Namespace: "default"},
+				Spec: directusv1.DirectusSpec{
+					NetworkPolicy: &directusv1.DirectusNetworkPolicy{Enabled: true},
+				},
+			}
+			Expect(controllerReconciler.buildIngressRule(directus).From).NotTo(ContainElement(namespacePeer("monitoring")))
+
+			directus.Spec.Metrics = &directusv1.DirectusMetrics{Enabled: true}
+			rule := controllerReconciler.buildIngressRule(directus)
+			Expect(rule.From).To(ContainElement(namespacePeer("monitoring")))
+			Expect(rule.Ports).To(ConsistOf(tcpPort(intstr.FromInt32(8055))))
+
+			By("setting the monitoring namespace")
+			directus.Spec.NetworkPolicy.MonitoringNamespace = "openshift-monitoring"
+			from := controllerReconciler.buildIngressRule(directus).From
+			Expect(from).To(ContainElement(namespacePeer("openshift-monitoring")))
+			Expect(from).NotTo(ContainElement(namespacePeer("monitoring")))
+		})
+	})
+
+	Context("When a pod template overlay is set", func() {
+		const resourceName = "overlay-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					ReplicaCount: 2,
+					PodTemplate: &runtime.RawExtension{Raw: []byte(`{
+						"metadata": {"labels": {"team": "content"}},
+						"spec": {
+							"priorityClassName": "high",
+							"terminationGracePeriodSeconds": 60,
+							"hostAliases": [{"ip": "10.0.0.1", "hostnames": ["db.internal"]}],
+							"containers": [{"name": "directus", "env": [{"name": "LOG_LEVEL", "value": "debug"}]}]
+						}
+					}`)},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should merge the overlay into the generated pod template", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+			template := deployment.Spec.Template
+			Expect(template.Labels).To(HaveKeyWithValue("team", "content"))
+			Expect(template.Labels).To(HaveKeyWithValue("app.kubernetes.io/instance", resourceName))
+			Expect(template.Spec.PriorityClassName).To(Equal("high"))
+			Expect(template.Spec.TerminationGracePeriodSeconds).To(HaveValue(BeEquivalentTo(60)))
+			Expect(template.Spec.HostAliases).To(HaveLen(1))
+			Expect(template.Spec.TopologySpreadConstraints).To(ConsistOf(
+				HaveField("TopologyKey", zoneTopologyKey)))
+
+			Expect(template.Spec.Containers).NotTo(BeEmpty())
+			container := template.Spec.Containers[0]
+			Expect(container.Image).NotTo(BeEmpty())
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "LOG_LEVEL", Value: "debug"}))
+		})
+	})
+
+	Context("When overrides are set", func() {
+		const resourceName = "overrides-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					Overrides: []directusv1.DirectusOverride{
+						{
+							Kind:  "Deployment",
+							Type:  "StrategicMerge",
+							Patch: runtime.RawExtension{Raw: []byte(`{"spec":{"revisionHistoryLimit":3,"template":{"metadata":{"annotations":{"example.com/team":"content"}}}}}`)},
+						},
+						{
+							Kind:  "Service",
+							Type:  "JSON",
+							Patch: runtime.RawExtension{Raw: []byte(`[{"op":"add","path":"/spec/sessionAffinity","value":"ClientIP"}]`)},
+						},
+						{
+							Kind:  "ConfigMap",
+							Type:  "JSON",
+							Patch: runtime.RawExtension{Raw: []byte(`[{"op":"add","path":"/data/EXTENSIONS_AUTO_RELOAD","value":"true"}]`)},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should patch generated objects and reject selector changes", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+			Expect(deployment.Spec.RevisionHistoryLimit).To(HaveValue(BeEquivalentTo(3)))
+			Expect(deployment.Spec.Template.Annotations).To(HaveKeyWithValue("example.com/team", "content"))
+
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+			Expect(service.Spec.SessionAffinity).To(Equal(corev1.ServiceAffinityClientIP))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-configmap", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("EXTENSIONS_AUTO_RELOAD", "true"))
+
+			By("reconciling again with the patches applied to the live objects")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+			Expect(service.Spec.SessionAffinity).To(Equal(corev1.ServiceAffinityClientIP))
+
+			By("patching an operator-owned selector")
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			directus.Spec.Overrides = append(directus.Spec.Overrides, directusv1.DirectusOverride{
+				Kind:  "Deployment",
+				Type:  "StrategicMerge",
+				Patch: runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"metadata":{"labels":{"app.kubernetes.io/instance":"other"}}}}}`)},
+			})
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+
+			Expect(controllerReconciler.validateSpec(ctx, directus)).To(ContainElement(
+				"spec.overrides[3] is ignored: Deployment /spec/template/metadata/labels/app.kubernetes.io~1instance is managed by the operator"))
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("app.kubernetes.io/instance", resourceName))
+			Expect(deployment.Spec.RevisionHistoryLimit).To(HaveValue(BeEquivalentTo(3)))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionSpecWarnings)).To(BeTrue())
+
+			By("moving an operator-owned selector label")
+			directus.Spec.Overrides[3] = directusv1.DirectusOverride{
+				Kind: "Deployment",
+				Type: "JSON",
+				Patch: runtime.RawExtension{Raw: []byte(`[{"op":"move",` +
+					`"from":"/spec/selector/matchLabels/app.kubernetes.io~1instance","path":"/metadata/labels/moved"}]`)},
+			}
+			Expect(controllerReconciler.validateSpec(ctx, directus)).To(ContainElement(
+				"spec.overrides[3] is ignored: Deployment /spec/selector is managed by the operator"))
+		})
+	})
+
+	Context("When common labels and annotations are set", func() {
+		const resourceName = "common-metadata-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+					Labels:    map[string]string{"example.com/cost-center": "cc-42", "unrelated": "value"},
+				},
+				Spec: directusv1.DirectusSpec{
+					CreateApplicationSecret: true,
+					CommonLabels: map[string]string{
+						"example.com/team":           "content",
+						"app.kubernetes.io/instance": "ignored",
+					},
+					CommonAnnotations: map[string]string{"example.com/owner": "content@example.com"},
+					PropagateLabels:   []string{"example.com/cost-center"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should propagate them to every generated object", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-configmap", Namespace: "default"}, configMap)).To(Succeed())
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-application-secret", Namespace: "default"}, secret)).To(Succeed())
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+
+			for _, object := range []metav1.ObjectMeta{service.ObjectMeta, configMap.ObjectMeta, secret.ObjectMeta, deployment.Spec.Template.ObjectMeta} {
+				Expect(object.Labels).To(HaveKeyWithValue("example.com/team", "content"))
+				Expect(object.Labels).To(HaveKeyWithValue("example.com/cost-center", "cc-42"))
+				Expect(object.Labels).NotTo(HaveKey("unrelated"))
+				Expect(object.Annotations).To(HaveKeyWithValue("example.com/owner", "content@example.com"))
+			}
+			Expect(service.Spec.Selector).To(HaveKeyWithValue("app.kubernetes.io/instance", resourceName))
+			Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("app.kubernetes.io/instance", resourceName))
+		})
+
+		It("should remove common labels and annotations dropped from the spec", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("dropping a common label and the common annotation")
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			delete(directus.Spec.CommonLabels, "example.com/team")
+			directus.Spec.CommonAnnotations = nil
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-configmap", Namespace: "default"}, configMap)).To(Succeed())
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+
+			for _, object := range []metav1.ObjectMeta{service.ObjectMeta, configMap.ObjectMeta, deployment.ObjectMeta, deployment.Spec.Template.ObjectMeta} {
+				Expect(object.Labels).NotTo(HaveKey("example.com/team"))
+				Expect(object.Labels).To(HaveKeyWithValue("example.com/cost-center", "cc-42"))
+				Expect(object.Annotations).NotTo(HaveKey("example.com/owner"))
+				Expect(object.Annotations).NotTo(HaveKey(commonAnnotationsAnnotation))
+			}
+			Expect(configMap.Annotations).To(HaveKeyWithValue(commonLabelsAnnotation, "example.com/cost-center"))
+			Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("app.kubernetes.io/instance", resourceName))
+		})
+	})
+
+	Context("When configuring the Service", func() {
+		const resourceName = "service-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					Service: directusv1.DirectusService{
+						Type:                     corev1.ServiceTypeLoadBalancer,
+						Annotations:              map[string]string{"service.beta.kubernetes.io/aws-load-balancer-type": "nlb"},
+						NodePort:                 30080,
+						LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+						ExternalTrafficPolicy:    corev1.ServiceExternalTrafficPolicyLocal,
+						SessionAffinity:          corev1.ServiceAffinityClientIP,
+						MetricsPort:              9090,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should apply the settings and switch service types in place", func() {
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+			Expect(service.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/aws-load-balancer-type", "nlb"))
+			Expect(service.Spec.Ports).To(HaveLen(2))
+			Expect(service.Spec.Ports[0].NodePort).To(BeEquivalentTo(30080))
+			Expect(service.Spec.Ports[1].Name).To(Equal("metrics"))
+			Expect(service.Spec.Ports[1].Port).To(BeEquivalentTo(9090))
+			Expect(service.Spec.LoadBalancerSourceRanges).To(ConsistOf("10.0.0.0/8"))
+			Expect(service.Spec.ExternalTrafficPolicy).To(Equal(corev1.ServiceExternalTrafficPolicyLocal))
+			Expect(service.Spec.SessionAffinity).To(Equal(corev1.ServiceAffinityClientIP))
+			metricsNodePort := service.Spec.Ports[1].NodePort
+			Expect(metricsNodePort).NotTo(BeZero())
+
+			By("reconciling again after a load balancer controller annotated the Service")
+			service.Annotations["cloud.google.com/neg-status"] = `{"network_endpoint_groups":{}}`
+			Expect(k8sClient.Update(ctx, service)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+			Expect(service.Spec.Ports[1].NodePort).To(Equal(metricsNodePort))
+			Expect(service.Annotations).To(HaveKey("cloud.google.com/neg-status"))
+
+			By("switching to a ClusterIP service")
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			directus.Spec.Service = directusv1.DirectusService{Type: corev1.ServiceTypeClusterIP}
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+			Expect(service.Spec.Type).To(Equal(corev1.ServiceTypeClusterIP))
+			Expect(service.Spec.Ports).To(HaveLen(1))
+			Expect(service.Spec.Ports[0].NodePort).To(BeZero())
+			Expect(service.Spec.ExternalTrafficPolicy).To(BeEmpty())
+			Expect(service.Spec.LoadBalancerSourceRanges).To(BeEmpty())
+			Expect(service.Annotations).NotTo(HaveKey("service.beta.kubernetes.io/aws-load-balancer-type"))
+			Expect(service.Annotations).To(HaveKey("cloud.google.com/neg-status"))
+		})
+	})
+
+	Context("When a Gateway API route is enabled", func() {
+		const resourceName = "gateway-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					Gateway: &directusv1.DirectusGateway{
+						Enabled:    true,
+						EnableTLS:  true,
+						ParentRefs: []directusv1.DirectusGatewayParentRef{{Name: "public", Namespace: "gateways"}},
+						Hostnames:  []string{"cms.example.com"},
+						Paths:      []directusv1.DirectusGatewayPath{{Value: "/"}},
+						Filters: []runtime.RawExtension{{Raw: []byte(
+							`{"type":"ResponseHeaderModifier","responseHeaderModifier":{"add":[{"name":"X-Frame-Options","value":"DENY"}]}}`)}},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should create the HTTPRoute and report its acceptance", func() {
+			available, err := HTTPRoutesAvailable(k8sClient.RESTMapper())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(available).To(BeTrue())
+
+			route := &unstructured.Unstructured{}
+			route.SetGroupVersionKind(httpRouteGVK)
+
+			By("reconciling without the Gateway API")
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			Expect(controllerReconciler.validateSpec(ctx, &directusv1.Directus{Spec: directusv1.DirectusSpec{
+				Gateway: &directusv1.DirectusGateway{Enabled: true},
+			}})).To(ContainElement(ContainSubstring("HTTPRoute kind was not found")))
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, route))).To(BeTrue())
+
+			By("reconciling with the Gateway API")
+			controllerReconciler.HTTPRoutes = true
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, route)).To(Succeed())
+			Expect(route.Object["spec"]).To(HaveKeyWithValue("hostnames", ConsistOf("cms.example.com")))
+			rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+			Expect(rules).To(HaveLen(1))
+			Expect(rules[0]).To(HaveKeyWithValue("backendRefs", ConsistOf(
+				map[string]any{"name": resourceName, "port": int64(80)})))
+			Expect(rules[0]).To(HaveKeyWithValue("filters", HaveLen(1)))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-configmap", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("PUBLIC_URL", "https://cms.example.com"))
+
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			condition := meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionRouteAccepted)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal("PendingAcceptance"))
+
+			By("accepting the route in the Gateway")
+			now := metav1.Now().Rfc3339Copy().Format(time.RFC3339)
+			Expect(unstructured.SetNestedSlice(route.Object, []any{map[string]any{
+				"parentRef":      map[string]any{"name": "public", "namespace": "gateways"},
+				"controllerName": "example.com/gateway-controller",
+				"conditions": []any{
+					map[string]any{"type": "Accepted", "status": "True", "reason": "Accepted", "message": "", "lastTransitionTime": now},
+					map[string]any{"type": "ResolvedRefs", "status": "True", "reason": "ResolvedRefs", "message": "", "lastTransitionTime": now},
+				},
+			}}, "status", "parents")).To(Succeed())
+			Expect(k8sClient.Status().Update(ctx, route)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionRouteAccepted)).To(BeTrue())
+
+			By("keeping the annotations of the Gateway controller")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, route)).To(Succeed())
+			route.SetAnnotations(map[string]string{"example.com/gateway-controller": "attached"})
+			Expect(k8sClient.Update(ctx, route)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, route)).To(Succeed())
+			Expect(route.GetAnnotations()).To(HaveKeyWithValue("example.com/gateway-controller", "attached"))
+
+			By("disabling the route")
+			directus.Spec.Gateway.Enabled = false
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, typeNamespacedName, route)
+			Expect(errors.IsNotFound(err) || route.GetDeletionTimestamp() != nil).To(BeTrue())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionRouteAccepted)).To(BeNil())
+		})
+	})
+
+	Context("When the ingress certificate is issued by cert-manager", func() {
+		const resourceName = "certificate-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					Ingress: directusv1.DirectusIngress{
+						Enabled:   true,
+						ClassName: "nginx",
+						Hosts: []directusv1.DirectusIngressHost{
+							{Host: "cms.example.com", Paths: []directusv1.DirectusIngressPath{{Path: "/"}}},
+							{Host: "admin.example.com", Paths: []directusv1.DirectusIngressPath{{Path: "/"}}},
+						},
+						Certificate: &directusv1.DirectusCertificate{
+							IssuerRef: directusv1.DirectusIssuerRef{Name: "letsencrypt", Kind: "ClusterIssuer"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should create the Certificate, wire the ingress TLS and report the expiry", func() {
+			available, err := CertificatesAvailable(k8sClient.RESTMapper())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(available).To(BeTrue())
+
+			certificate := &unstructured.Unstructured{}
+			certificate.SetGroupVersionKind(certificateGVK)
+
+			By("reconciling without cert-manager")
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(controllerReconciler.validateSpec(ctx, directus)).To(ContainElement(ContainSubstring("Certificate kind was not found")))
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, certificate))).To(BeTrue())
+
+			By("reconciling with cert-manager")
+			controllerReconciler.Certificates = true
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, certificate)).To(Succeed())
+			Expect(certificate.Object["spec"]).To(HaveKeyWithValue("secretName", resourceName+"-tls"))
+			Expect(certificate.Object["spec"]).To(HaveKeyWithValue("dnsNames", ConsistOf("cms.example.com", "admin.example.com")))
+			Expect(certificate.Object["spec"]).To(HaveKeyWithValue("issuerRef", map[string]any{
+				"name": "letsencrypt", "kind": "ClusterIssuer", "group": "cert-manager.io",
+			}))
+
+			ingress := &networkingv1.Ingress{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, ingress)).To(Succeed())
+			Expect(ingress.Spec.TLS).To(ConsistOf(networkingv1.IngressTLS{
+				Hosts:      []string{"cms.example.com", "admin.example.com"},
+				SecretName: resourceName + "-tls",
+			}))
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			condition := meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionCertificateReady)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal("Pending"))
+
+			By("issuing the certificate")
+			now := metav1.Now().Rfc3339Copy()
+			notAfter := now.Add(90 * 24 * time.Hour).UTC().Format(time.RFC3339)
+			certificate.Object["status"] = map[string]any{
+				"notAfter":    notAfter,
+				"renewalTime": now.Add(60 * 24 * time.Hour).UTC().Format(time.RFC3339),
+				"conditions": []any{map[string]any{
+					"type": "Ready", "status": "True", "reason": "Ready", "message": "Certificate is up to date",
+					"lastTransitionTime": now.Format(time.RFC3339),
+				}},
+			}
+			Expect(k8sClient.Status().Update(ctx, certificate)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionCertificateReady)).To(BeTrue())
+			Expect(directus.Status.Certificate).NotTo(BeNil())
+			Expect(directus.Status.Certificate.NotAfter.UTC().Format(time.RFC3339)).To(Equal(notAfter))
+			Expect(directus.Status.Certificate.RenewalTime).NotTo(BeNil())
+
+			By("removing every ingress host")
+			for i := range directus.Spec.Ingress.Hosts {
+				directus.Spec.Ingress.Hosts[i].Host = ""
+			}
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+			Expect(controllerReconciler.validateSpec(ctx, directus)).To(ContainElement(ContainSubstring("no ingress host is set")))
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, certificate))).To(BeTrue())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, ingress)).To(Succeed())
+			Expect(ingress.Spec.TLS).To(BeEmpty())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionCertificateReady)).To(BeNil())
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionSpecWarnings)).To(BeTrue())
+		})
+	})
+
+	Context("When an OpenShift Route is enabled", func() {
+		const resourceName = "openshift-route-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					Route: &directusv1.DirectusRoute{
+						Enabled: true,
+						Host:    "cms.apps.example.com",
+						TLS: &directusv1.DirectusRouteTLS{
+							Termination:                   "edge",
+							InsecureEdgeTerminationPolicy: "Redirect",
+						},
+					},
+					Ingress: directusv1.DirectusIngress{
+						Enabled: true,
+						Hosts: []directusv1.DirectusIngressHost{
+							{Host: "cms.example.com", Paths: []directusv1.DirectusIngressPath{{Path: "/"}}},
+						},
+					},
+					NetworkPolicy: &directusv1.DirectusNetworkPolicy{Enabled: true},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should only create the Route when the Route API is available", func() {
+			available, err := OpenShiftRoutesAvailable(k8sClient.RESTMapper())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(available).To(BeTrue())
+
+			route := &unstructured.Unstructured{}
+			route.SetGroupVersionKind(openShiftRouteGVK)
+
+			By("reconciling without the Route API")
+			controllerReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			Expect(controllerReconciler.validateSpec(ctx, &directusv1.Directus{Spec: directusv1.DirectusSpec{
+				Route: &directusv1.DirectusRoute{Enabled: true},
+			}})).To(ContainElement(ContainSubstring("Route API was not found")))
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, route))).To(BeTrue())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, &networkingv1.Ingress{})).To(Succeed())
+
+			By("reconciling with the Route API")
+			controllerReconciler.OpenShiftRoutes = true
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(controllerReconciler.validateSpec(ctx, directus)).To(ContainElement(ContainSubstring("ingress is ignored")))
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, route)).To(Succeed())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, typeNamespacedName, &networkingv1.Ingress{}))).To(BeTrue())
+
+			policy := &networkingv1.NetworkPolicy{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, policy)).To(Succeed())
+			Expect(policy.Spec.Ingress[0].From).To(ContainElement(namespacePeer("openshift-ingress")))
+			Expect(policy.Spec.Ingress[0].From).NotTo(ContainElement(namespacePeer("ingress-nginx")))
+			Expect(route.Object["spec"]).To(HaveKeyWithValue("host", "cms.apps.example.com"))
+			Expect(route.Object["spec"]).To(HaveKeyWithValue("tls", map[string]any{
+				"termination": "edge", "insecureEdgeTerminationPolicy": "Redirect",
+			}))
+			Expect(route.Object["spec"]).To(HaveKeyWithValue("to", HaveKeyWithValue("name", resourceName)))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-configmap", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("PUBLIC_URL", "https://cms.apps.example.com"))
+
+			By("keeping the annotations of OpenShift tooling")
+			route.SetAnnotations(map[string]string{"openshift.io/host.generated": "false"})
+			Expect(k8sClient.Update(ctx, route)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, route)).To(Succeed())
+			Expect(route.GetAnnotations()).To(HaveKeyWithValue("openshift.io/host.generated", "false"))
+
+			By("admitting the Route")
+			route.Object["status"] = map[string]any{
+				"ingress": []any{map[string]any{
+					"host":       "cms.apps.example.com",
+					"routerName": "default",
+					"conditions": []any{map[string]any{"type": "Admitted", "status": "True"}},
+				}},
+			}
+			Expect(k8sClient.Status().Update(ctx, route)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionRouteAdmitted)).To(BeTrue())
+			Expect(directus.Status.Route).NotTo(BeNil())
+			Expect(directus.Status.Route.Hosts).To(ConsistOf("cms.apps.example.com"))
+		})
+	})
+
+	Context("When Prometheus metrics are enabled", func() {
+		const resourceName = "metrics-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			resource := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					Metrics: &directusv1.DirectusMetrics{
+						Enabled:  true,
+						Services: []string{"database", "cache"},
+						TokenSecretRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "metrics-token"},
+							Key:                  "token",
+						},
+						ServiceMonitor: &directusv1.DirectusServiceMonitor{
+							Enabled:  true,
+							Labels:   map[string]string{"release": "prometheus"},
+							Interval: "30s",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should expose the metrics and create the ServiceMonitor", func() {
+			available, err := ServiceMonitorsAvailable(k8sClient.RESTMapper())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(available).To(BeTrue())
+
+			controllerReconciler := &DirectusReconciler{
+				Client:          k8sClient,
+				Scheme:          k8sClient.Scheme(),
+				ServiceMonitors: true,
+			}
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-configmap", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("METRICS_ENABLED", "true"))
+			Expect(configMap.Data).To(HaveKeyWithValue("METRICS_SERVICES", "database,cache"))
+
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(ContainElement(HaveField("Name", "METRICS_TOKENS")))
+
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, service)).To(Succeed())
+			Expect(service.Labels).To(HaveKeyWithValue("app.kubernetes.io/instance", resourceName))
+			Expect(service.Spec.Ports).To(ContainElement(SatisfyAll(
+				HaveField("Name", "metrics"), HaveField("Port", BeEquivalentTo(9090)))))
+
+			monitor := &unstructured.Unstructured{}
+			monitor.SetGroupVersionKind(serviceMonitorGVK)
+			Expect(k8sClient.Get(ctx, typeNamespacedName, monitor)).To(Succeed())
+			Expect(monitor.GetLabels()).To(HaveKeyWithValue("release", "prometheus"))
+			endpoints, _, _ := unstructured.NestedSlice(monitor.Object, "spec", "endpoints")
+			Expect(endpoints).To(ConsistOf(SatisfyAll(
+				HaveKeyWithValue("port", "metrics"),
+				HaveKeyWithValue("interval", "30s"),
+				HaveKeyWithValue("authorization", HaveKeyWithValue("type", "Metrics")),
+			)))
+
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, directus)).To(Succeed())
+			Expect(controllerReconciler.validateSpec(ctx, directus)).NotTo(ContainElement(ContainSubstring("metrics.tokenSecretRef")))
+			withoutToken := directus.DeepCopy()
+			withoutToken.Spec.Metrics.TokenSecretRef = nil
+			Expect(controllerReconciler.validateSpec(ctx, withoutToken)).To(ContainElement(ContainSubstring(
+				"metrics.serviceMonitor is set without metrics.tokenSecretRef")))
+
+			By("disabling the ServiceMonitor")
+			directus.Spec.Metrics.ServiceMonitor.Enabled = false
+			Expect(k8sClient.Update(ctx, directus)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, typeNamespacedName, monitor)
+			Expect(errors.IsNotFound(err) || monitor.GetDeletionTimestamp() != nil).To(BeTrue())
+		})
+	})
 })
@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+	"github.com/example/directus-operator/internal/directusapi"
+)
+
+// emailHealthCheck is the /server/health check Directus reports for the transport
+const emailHealthCheck = "email:connection"
+
+// buildEmailConfig adds the non-secret EMAIL_* settings to the ConfigMap data
+func (r *DirectusReconciler) buildEmailConfig(directus *directusv1.Directus, data map[string]string) {
+	email := directus.Spec.Email
+	if email == nil {
+		return
+	}
+
+	if email.Transport != "" {
+		data["EMAIL_TRANSPORT"] = email.Transport
+	}
+	if email.From != "" {
+		data["EMAIL_FROM"] = email.From
+	}
+	if email.VerifySetup != nil {
+		data["EMAIL_VERIFY_SETUP"] = strconv.FormatBool(*email.VerifySetup)
+	}
+
+	switch email.Transport {
+	case "smtp":
+		if email.SMTP.Host != "" {
+			data["EMAIL_SMTP_HOST"] = email.SMTP.Host
+		}
+		if email.SMTP.Port > 0 {
+			data["EMAIL_SMTP_PORT"] = strconv.Itoa(int(email.SMTP.Port))
+		}
+		if email.SMTP.User != "" {
+			data["EMAIL_SMTP_USER"] = email.SMTP.User
+		}
+		if email.SMTP.Name != "" {
+			data["EMAIL_SMTP_NAME"] = email.SMTP.Name
+		}
+		data["EMAIL_SMTP_SECURE"] = strconv.FormatBool(email.SMTP.Secure)
+		data["EMAIL_SMTP_IGNORE_TLS"] = strconv.FormatBool(email.SMTP.IgnoreTLS)
+		data["EMAIL_SMTP_POOL"] = strconv.FormatBool(email.SMTP.Pool)
+	case "mailgun":
+		if email.Mailgun.Domain != "" {
+			data["EMAIL_MAILGUN_DOMAIN"] = email.Mailgun.Domain
+		}
+		if email.Mailgun.Host != "" {
+			data["EMAIL_MAILGUN_HOST"] = email.Mailgun.Host
+		}
+	case "ses":
+		if email.SES.Region != "" {
+			data["EMAIL_SES_REGION"] = email.SES.Region
+		}
+	}
+}
+
+// buildEmailEnvVars returns the EMAIL_* variables sourced from the credentials secret
+func (r *DirectusReconciler) buildEmailEnvVars(directus *directusv1.Directus) []corev1.EnvVar {
+	email := directus.Spec.Email
+	if email == nil || email.ExistingSecret == "" {
+		return nil
+	}
+
+	var keys map[string]string
+	switch email.Transport {
+	case "smtp":
+		keys = map[string]string{"EMAIL_SMTP_PASSWORD": "password"}
+	case "mailgun":
+		keys = map[string]string{"EMAIL_MAILGUN_API_KEY": "apiKey"}
+	case "sendgrid":
+		keys = map[string]string{"EMAIL_SENDGRID_API_KEY": "apiKey"}
+	case "ses":
+		keys = map[string]string{
+			"EMAIL_SES_CREDENTIALS__ACCESS_KEY_ID":     "accessKeyId",
+			"EMAIL_SES_CREDENTIALS__SECRET_ACCESS_KEY": "secretAccessKey",
+		}
+	}
+
+	// SMTP servers without authentication (e.g. MailHog) need no password
+	optional := email.Transport == "smtp"
+	envVars := []corev1.EnvVar{}
+	for _, name := range slices.Sorted(maps.Keys(keys)) {
+		envVars = append(envVars, corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: email.ExistingSecret,
+					},
+					Key:      keys[name],
+					Optional: &optional,
+				},
+			},
+		})
+	}
+	return envVars
+}
+
+// setEmailCondition sets EmailReady from the email health check. Directus
+// versions and setups that do not report the check leave it Unknown.
+func setEmailCondition(directus *directusv1.Directus, health *directusapi.Health) {
+	if directus.Spec.Email == nil {
+		meta.RemoveStatusCondition(&directus.Status.Conditions, directusv1.ConditionEmailReady)
+		directus.Status.EmailReady = false
+		return
+	}
+
+	var checks []directusapi.HealthCheck
+	if health != nil {
+		checks = health.Checks[emailHealthCheck]
+	}
+	switch {
+	case health == nil:
+		setCondition(directus, directusv1.ConditionEmailReady, metav1.ConditionUnknown, "HealthUnavailable",
+			"The detailed Directus health report is not available")
+	case len(checks) == 0:
+		setCondition(directus, directusv1.ConditionEmailReady, metav1.ConditionUnknown, "HealthCheckMissing",
+			"Directus does not report the "+emailHealthCheck+" health check")
+	case slices.ContainsFunc(checks, func(check directusapi.HealthCheck) bool { return check.Status == "error" }):
+		setCondition(directus, directusv1.ConditionEmailReady, metav1.ConditionFalse, "HealthCheckFailed",
+			"Health check "+emailHealthCheck+" failed")
+	default:
+		setCondition(directus, directusv1.ConditionEmailReady, metav1.ConditionTrue, "HealthCheckPassed",
+			"Directus health checks passed")
+	}
+	directus.Status.EmailReady = meta.IsStatusConditionTrue(directus.Status.Conditions, directusv1.ConditionEmailReady)
+}
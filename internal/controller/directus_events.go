@@ -0,0 +1,173 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// Event reasons recorded on Directus resources. They are part of the
+// operator's interface, alerting rules may match on them, so do not rename.
+const (
+	// ReasonCreated is recorded when a child resource is created
+	ReasonCreated = "Created"
+	// ReasonUpdated is recorded when a child resource is changed
+	ReasonUpdated = "Updated"
+	// ReasonDeleted is recorded when a child resource is no longer needed and removed
+	ReasonDeleted = "Deleted"
+	// ReasonCreateFailed is recorded when a child resource cannot be created
+	ReasonCreateFailed = "CreateFailed"
+	// ReasonUpdateFailed is recorded when a child resource cannot be updated
+	ReasonUpdateFailed = "UpdateFailed"
+	// ReasonSecretGenerated is recorded when the application secret is generated
+	ReasonSecretGenerated = "SecretGenerated"
+	// ReasonAdminCredentialsUpdated is recorded when the admin email or password is applied to the instance
+	ReasonAdminCredentialsUpdated = "AdminCredentialsUpdated"
+	// ReasonAdminPasswordRotated is recorded when a new admin password is generated
+	ReasonAdminPasswordRotated = "AdminPasswordRotated"
+	// ReasonSecretsRotated is recorded when KEY and SECRET are rotated
+	ReasonSecretsRotated = "SecretsRotated"
+	// ReasonSecretNotFound is recorded when a referenced secret does not exist
+	ReasonSecretNotFound = "SecretNotFound"
+	// ReasonRolloutStarted is recorded when the pod template changes
+	ReasonRolloutStarted = "RolloutStarted"
+	// ReasonPhaseChanged is recorded when status.phase changes
+	ReasonPhaseChanged = "PhaseChanged"
+	// ReasonSpecWarning is recorded for accepted but questionable configuration
+	ReasonSpecWarning = "SpecWarning"
+)
+
+// recordEvent records an event on the Directus resource if a recorder is set
+func (r *DirectusReconciler) recordEvent(directus *directusv1.Directus, eventType, reason, messageFmt string, args ...any) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Eventf(directus, eventType, reason, messageFmt, args...)
+}
+
+// kindOf returns the kind of a typed object for use in event messages
+func (r *DirectusReconciler) kindOf(obj client.Object) string {
+	gvk, err := apiutil.GVKForObject(obj, r.Scheme)
+	if err != nil {
+		return fmt.Sprintf("%T", obj)
+	}
+	return gvk.Kind
+}
+
+// createChild creates a child resource with the common metadata, patched by
+// spec.overrides, and records the outcome
+func (r *DirectusReconciler) createChild(ctx context.Context, directus *directusv1.Directus, obj client.Object) error {
+	r.applyCommonMetadata(directus, obj)
+	if err := r.applyOverrides(directus, obj); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, obj); err != nil {
+		r.recordEvent(directus, corev1.EventTypeWarning, ReasonCreateFailed,
+			"Failed to create %s %s: %v", r.kindOf(obj), obj.GetName(), err)
+		return err
+	}
+	r.recordEvent(directus, corev1.EventTypeNormal, ReasonCreated, "Created %s %s", r.kindOf(obj), obj.GetName())
+	return nil
+}
+
+// updateChild updates a child resource with the common metadata, patched by
+// spec.overrides, and records an event if it changed
+func (r *DirectusReconciler) updateChild(ctx context.Context, directus *directusv1.Directus, obj client.Object) error {
+	r.applyCommonMetadata(directus, obj)
+	if err := r.applyOverrides(directus, obj); err != nil {
+		return err
+	}
+	resourceVersion := obj.GetResourceVersion()
+	if err := r.Update(ctx, obj); err != nil {
+		r.recordEvent(directus, corev1.EventTypeWarning, ReasonUpdateFailed,
+			"Failed to update %s %s: %v", r.kindOf(obj), obj.GetName(), err)
+		return err
+	}
+	// No-op updates keep the resource version
+	if obj.GetResourceVersion() != resourceVersion {
+		r.recordEvent(directus, corev1.EventTypeNormal, ReasonUpdated, "Updated %s %s", r.kindOf(obj), obj.GetName())
+	}
+	return nil
+}
+
+// deleteChild deletes a child resource if it exists and records an event
+func (r *DirectusReconciler) deleteChild(ctx context.Context, directus *directusv1.Directus, obj client.Object) error {
+	if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(obj, directus) {
+		return nil
+	}
+	if err := r.Delete(ctx, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	r.recordEvent(directus, corev1.EventTypeNormal, ReasonDeleted, "Deleted %s %s", r.kindOf(obj), obj.GetName())
+	return nil
+}
+
+// updateDeployment updates the Deployment and records a rollout when the pod
+// template changed
+func (r *DirectusReconciler) updateDeployment(ctx context.Context, directus *directusv1.Directus, found *appsv1.Deployment, desired *appsv1.DeploymentSpec) error {
+	template := found.Spec.Template.DeepCopy()
+	found.Spec = *desired
+	if err := r.updateChild(ctx, directus, found); err != nil {
+		return err
+	}
+	// Compare the defaulted templates returned by the API server
+	if !equality.Semantic.DeepEqual(template, &found.Spec.Template) {
+		r.recordEvent(directus, corev1.EventTypeNormal, ReasonRolloutStarted,
+			"Rolling out new pod template for Deployment %s", found.Name)
+	}
+	return nil
+}
+
+// checkReferencedSecrets records a warning for every referenced secret that does not exist
+func (r *DirectusReconciler) checkReferencedSecrets(ctx context.Context, directus *directusv1.Directus) error {
+	secretNames := append([]string{}, directus.Spec.AttachExistingSecrets...)
+	if directus.Spec.Database.ExistingSecret != "" {
+		secretNames = append(secretNames, directus.Spec.Database.ExistingSecret)
+	}
+	if directus.Spec.Redis.Enabled && directus.Spec.Redis.ExistingSecret != "" {
+		secretNames = append(secretNames, directus.Spec.Redis.ExistingSecret)
+	}
+	if directus.Spec.Email != nil && directus.Spec.Email.ExistingSecret != "" {
+		secretNames = append(secretNames, directus.Spec.Email.ExistingSecret)
+	}
+
+	for _, name := range secretNames {
+		secret := &corev1.Secret{}
+		err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: directus.Namespace}, secret)
+		if err != nil && errors.IsNotFound(err) {
+			r.recordEvent(directus, corev1.EventTypeWarning, ReasonSecretNotFound, "Referenced Secret %s not found", name)
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// externalSecretGVK is the External Secrets Operator ExternalSecret kind
+var externalSecretGVK = schema.GroupVersionKind{Group: "external-secrets.io", Version: "v1", Kind: "ExternalSecret"}
+
+// defaultVaultKeys are injected from Vault when no keys are configured
+var defaultVaultKeys = []string{"ADMIN_PASSWORD", "KEY", "SECRET"}
+
+// usesApplicationSecret reports whether the pods read the application secret.
+// With spec.secretsFrom only a synced ExternalSecret provides one,
+// createApplicationSecret is ignored.
+func usesApplicationSecret(directus *directusv1.Directus) bool {
+	if from := directus.Spec.SecretsFrom; from != nil {
+		return from.ExternalSecret != nil
+	}
+	return directus.Spec.CreateApplicationSecret
+}
+
+// reconcileSecretsFrom creates the objects reading the application credentials
+// from an external store and reports whether the credentials are available.
+// The Deployment is not rolled out before they are.
+func (r *DirectusReconciler) reconcileSecretsFrom(ctx context.Context, directus *directusv1.Directus) (bool, error) {
+	from := directus.Spec.SecretsFrom
+	if from == nil {
+		meta.RemoveStatusCondition(&directus.Status.Conditions, directusv1.ConditionSecretsReady)
+		return true, nil
+	}
+	if from.ExternalSecret == nil {
+		setCondition(directus, directusv1.ConditionSecretsReady, metav1.ConditionTrue, "VaultAgentInjection",
+			"Credentials are injected by the Vault Agent")
+		return true, nil
+	}
+
+	if err := r.reconcileExternalSecret(ctx, directus); err != nil {
+		return false, err
+	}
+	name := applicationSecretName(directus)
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: directus.Namespace}, &corev1.Secret{})
+	if errors.IsNotFound(err) {
+		setCondition(directus, directusv1.ConditionSecretsReady, metav1.ConditionFalse, "SecretNotSynced",
+			fmt.Sprintf("Waiting for ExternalSecret %s to create Secret %s", name, name))
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	setCondition(directus, directusv1.ConditionSecretsReady, metav1.ConditionTrue, "ExternalSecretSynced",
+		fmt.Sprintf("Secret %s was created from the external store", name))
+	return true, nil
+}
+
+// reconcileExternalSecret creates or updates the ExternalSecret targeting the application secret
+func (r *DirectusReconciler) reconcileExternalSecret(ctx context.Context, directus *directusv1.Directus) error {
+	spec, err := buildExternalSecretSpec(directus)
+	if err != nil {
+		return err
+	}
+
+	externalSecret := &unstructured.Unstructured{}
+	externalSecret.SetGroupVersionKind(externalSecretGVK)
+	externalSecret.SetName(applicationSecretName(directus))
+	externalSecret.SetNamespace(directus.Namespace)
+	externalSecret.SetLabels(r.getLabels(directus))
+	externalSecret.Object["spec"] = spec
+	if err := controllerutil.SetControllerReference(directus, externalSecret, r.Scheme); err != nil {
+		return err
+	}
+
+	found := &unstructured.Unstructured{}
+	found.SetGroupVersionKind(externalSecretGVK)
+	err = r.Get(ctx, types.NamespacedName{Name: externalSecret.GetName(), Namespace: directus.Namespace}, found)
+	if meta.IsNoMatchError(err) {
+		return fmt.Errorf("spec.secretsFrom.externalSecret requires the External Secrets Operator CRDs: %w", err)
+	} else if err != nil && errors.IsNotFound(err) {
+		return r.createChild(ctx, directus, externalSecret)
+	} else if err != nil {
+		return err
+	}
+
+	found.Object["spec"] = spec
+	return r.updateChild(ctx, directus, found)
+}
+
+// buildExternalSecretSpec returns the spec of the ExternalSecret. The
+// ExternalSecret owns the Secret it creates.
+func buildExternalSecretSpec(directus *directusv1.Directus) (map[string]any, error) {
+	source := directus.Spec.SecretsFrom.ExternalSecret
+
+	refreshInterval := time.Hour
+	if source.RefreshInterval != nil {
+		refreshInterval = source.RefreshInterval.Duration
+	}
+	storeKind := source.SecretStoreRef.Kind
+	if storeKind == "" {
+		storeKind = "SecretStore"
+	}
+
+	target := map[string]any{
+		"name":           applicationSecretName(directus),
+		"creationPolicy": "Owner",
+	}
+	if source.Template != nil && len(source.Template.Raw) > 0 {
+		var template map[string]any
+		if err := json.Unmarshal(source.Template.Raw, &template); err != nil {
+			return nil, fmt.Errorf("invalid spec.secretsFrom.externalSecret.template: %w", err)
+		}
+		target["template"] = template
+	}
+
+	spec := map[string]any{
+		"refreshInterval": refreshInterval.String(),
+		"secretStoreRef": map[string]any{
+			"name": source.SecretStoreRef.Name,
+			"kind": storeKind,
+		},
+		"target": target,
+	}
+
+	var data []any
+	for _, item := range source.Data {
+		remoteRef := map[string]any{"key": item.RemoteKey}
+		if item.Property != "" {
+			remoteRef["property"] = item.Property
+		}
+		data = append(data, map[string]any{"secretKey": item.SecretKey, "remoteRef": remoteRef})
+	}
+	if len(data) > 0 {
+		spec["data"] = data
+	}
+
+	var dataFrom []any
+	for _, key := range source.DataFrom {
+		dataFrom = append(dataFrom, map[string]any{"extract": map[string]any{"key": key}})
+	}
+	if len(dataFrom) > 0 {
+		spec["dataFrom"] = dataFrom
+	}
+	return spec, nil
+}
+
+// vaultKeys returns the keys injected from Vault
+func vaultKeys(vault *directusv1.DirectusVaultSecrets) []string {
+	if len(vault.Keys) > 0 {
+		return vault.Keys
+	}
+	return defaultVaultKeys
+}
+
+// vaultInjectAnnotation enables the Vault Agent injector on a pod, and
+// vaultPrePopulateOnlyAnnotation limits it to an init container, for Jobs
+const (
+	vaultInjectAnnotation          = "vault.hashicorp.com/agent-inject"
+	vaultPrePopulateOnlyAnnotation = "vault.hashicorp.com/agent-pre-populate-only"
+)
+
+// vaultAnnotations returns the pod annotations having the Vault Agent injector
+// write each key to /vault/secrets/<KEY>
+func vaultAnnotations(vault *directusv1.DirectusVaultSecrets) map[string]string {
+	annotations := map[string]string{
+		vaultInjectAnnotation:      "true",
+		"vault.hashicorp.com/role": vault.Role,
+	}
+	for _, key := range vaultKeys(vault) {
+		field := ".Data.data." + key
+		if vault.KVVersion == 1 {
+			field = ".Data." + key
+		}
+		annotations["vault.hashicorp.com/agent-inject-secret-"+key] = vault.Path
+		annotations["vault.hashicorp.com/agent-inject-template-"+key] =
+			fmt.Sprintf(`{{- with secret %q -}}{{ %s }}{{- end -}}`, vault.Path, field)
+	}
+	return annotations
+}
+
+// vaultEnvVars points Directus at the injected files, it reads <KEY>_FILE for any variable
+func vaultEnvVars(vault *directusv1.DirectusVaultSecrets) []corev1.EnvVar {
+	var env []corev1.EnvVar
+	for _, key := range vaultKeys(vault) {
+		env = append(env, corev1.EnvVar{Name: key + "_FILE", Value: "/vault/secrets/" + key})
+	}
+	return env
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// httpRouteGVK is the Gateway API HTTPRoute kind
+var httpRouteGVK = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"}
+
+// HTTPRoutesAvailable reports whether the cluster serves the Gateway API
+// HTTPRoute kind. It is checked once at startup.
+func HTTPRoutesAvailable(mapper meta.RESTMapper) (bool, error) {
+	return kindAvailable(mapper, httpRouteGVK)
+}
+
+// gatewayEnabled reports whether an HTTPRoute routes to the instance, which
+// requires the Gateway API
+func (r *DirectusReconciler) gatewayEnabled(directus *directusv1.Directus) bool {
+	return r.HTTPRoutes && directus.Spec.Gateway != nil && directus.Spec.Gateway.Enabled
+}
+
+// reconcileHTTPRoute creates or updates the HTTPRoute pointing at the Directus
+// Service, and removes it when the gateway route is disabled
+func (r *DirectusReconciler) reconcileHTTPRoute(ctx context.Context, directus *directusv1.Directus) error {
+	if !r.HTTPRoutes {
+		return nil
+	}
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(httpRouteGVK)
+	route.SetName(directus.Name)
+	route.SetNamespace(directus.Namespace)
+
+	if !r.gatewayEnabled(directus) {
+		return r.deleteChild(ctx, directus, route)
+	}
+
+	spec, err := buildHTTPRouteSpec(directus)
+	if err != nil {
+		return err
+	}
+	route.SetLabels(r.getLabels(directus))
+	route.SetAnnotations(ownAnnotations(directus.Spec.Gateway.Annotations))
+	route.Object["spec"] = spec
+	if err := controllerutil.SetControllerReference(directus, route, r.Scheme); err != nil {
+		return err
+	}
+
+	found := &unstructured.Unstructured{}
+	found.SetGroupVersionKind(httpRouteGVK)
+	err = r.Get(ctx, types.NamespacedName{Name: route.GetName(), Namespace: directus.Namespace}, found)
+	if err != nil && errors.IsNotFound(err) {
+		return r.createChild(ctx, directus, route)
+	} else if err != nil {
+		return err
+	}
+
+	// Keep the annotations Gateway controllers write
+	mergeOwnedMetadata(found, route)
+	found.Object["spec"] = spec
+	return r.updateChild(ctx, directus, found)
+}
+
+// buildHTTPRouteSpec returns the spec of the HTTPRoute, with a single rule
+// sending all matching requests to the Directus Service
+func buildHTTPRouteSpec(directus *directusv1.Directus) (map[string]any, error) {
+	gateway := directus.Spec.Gateway
+
+	parentRefs := []any{}
+	for _, ref := range gateway.ParentRefs {
+		parentRef := map[string]any{
+			"group": httpRouteGVK.Group,
+			"kind":  "Gateway",
+			"name":  ref.Name,
+		}
+		if ref.Namespace != "" {
+			parentRef["namespace"] = ref.Namespace
+		}
+		if ref.SectionName != "" {
+			parentRef["sectionName"] = ref.SectionName
+		}
+		parentRefs = append(parentRefs, parentRef)
+	}
+
+	matches := []any{}
+	for _, path := range gateway.Paths {
+		matchType := path.Type
+		if matchType == "" {
+			matchType = "PathPrefix"
+		}
+		matches = append(matches, map[string]any{
+			"path": map[string]any{"type": matchType, "value": path.Value},
+		})
+	}
+	if len(matches) == 0 {
+		matches = append(matches, map[string]any{
+			"path": map[string]any{"type": "PathPrefix", "value": "/"},
+		})
+	}
+
+	rule := map[string]any{
+		"matches": matches,
+		"backendRefs": []any{map[string]any{
+			"name": directus.Name,
+			"port": int64(directus.Spec.Service.Port),
+		}},
+	}
+	if len(gateway.Filters) > 0 {
+		filters := []any{}
+		for i, filter := range gateway.Filters {
+			var value map[string]any
+			if err := json.Unmarshal(filter.Raw, &value); err != nil {
+				return nil, fmt.Errorf("invalid spec.gateway.filters[%d]: %w", i, err)
+			}
+			filters = append(filters, value)
+		}
+		rule["filters"] = filters
+	}
+
+	spec := map[string]any{
+		"parentRefs": parentRefs,
+		"rules":      []any{rule},
+	}
+	if len(gateway.Hostnames) > 0 {
+		hostnames := []any{}
+		for _, hostname := range gateway.Hostnames {
+			hostnames = append(hostnames, hostname)
+		}
+		spec["hostnames"] = hostnames
+	}
+	return spec, nil
+}
+
+// routeParentStatus is the status a Gateway reports for an HTTPRoute
+type routeParentStatus struct {
+	ParentRef struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace,omitempty"`
+	} `json:"parentRef"`
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// setRouteStatus sets RouteAccepted from the conditions the Gateways report
+// in the status of the HTTPRoute
+func (r *DirectusReconciler) setRouteStatus(ctx context.Context, directus *directusv1.Directus) error {
+	if !r.gatewayEnabled(directus) {
+		meta.RemoveStatusCondition(&directus.Status.Conditions, directusv1.ConditionRouteAccepted)
+		return nil
+	}
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(httpRouteGVK)
+	err := r.Get(ctx, types.NamespacedName{Name: directus.Name, Namespace: directus.Namespace}, route)
+	if err != nil && errors.IsNotFound(err) {
+		setCondition(directus, directusv1.ConditionRouteAccepted, metav1.ConditionFalse, "RouteNotFound",
+			"HTTPRoute has not been created")
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var parents []routeParentStatus
+	if raw, ok, _ := unstructured.NestedSlice(route.Object, "status", "parents"); ok {
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &parents); err != nil {
+			return err
+		}
+	}
+	if len(parents) == 0 {
+		setCondition(directus, directusv1.ConditionRouteAccepted, metav1.ConditionFalse, "PendingAcceptance",
+			"Waiting for the Gateway to accept the HTTPRoute")
+		return nil
+	}
+
+	accepted := []string{}
+	for _, parent := range parents {
+		gateway := parent.ParentRef.Name
+		if parent.ParentRef.Namespace != "" {
+			gateway = parent.ParentRef.Namespace + "/" + gateway
+		}
+		for _, conditionType := range []string{"Accepted", "ResolvedRefs"} {
+			condition := meta.FindStatusCondition(parent.Conditions, conditionType)
+			if condition == nil {
+				setCondition(directus, directusv1.ConditionRouteAccepted, metav1.ConditionFalse, "PendingAcceptance",
+					fmt.Sprintf("Waiting for Gateway %s to accept the HTTPRoute", gateway))
+				return nil
+			}
+			if condition.Status != metav1.ConditionTrue {
+				setCondition(directus, directusv1.ConditionRouteAccepted, metav1.ConditionFalse, condition.Reason,
+					fmt.Sprintf("Gateway %s: %s", gateway, condition.Message))
+				return nil
+			}
+		}
+		accepted = append(accepted, gateway)
+	}
+	setCondition(directus, directusv1.ConditionRouteAccepted, metav1.ConditionTrue, "Accepted",
+		fmt.Sprintf("HTTPRoute accepted by %s", strings.Join(accepted, ", ")))
+	return nil
+}
+
+// publicURL returns the URL Directus is reached at, derived from the first
+// ingress host, the OpenShift Route host or the first HTTPRoute hostname.
+// Wildcard hostnames are skipped.
+func (r *DirectusReconciler) publicURL(directus *directusv1.Directus) string {
+	scheme := func(tls bool) string {
+		if tls {
+			return "https"
+		}
+		return "http"
+	}
+	if ingress := directus.Spec.Ingress; r.ingressEnabled(directus) {
+		for _, host := range ingress.Hosts {
+			if host.Host != "" && !strings.HasPrefix(host.Host, "*") {
+				return scheme(ingress.EnableTLS || r.certificateEnabled(directus)) + "://" + host.Host
+			}
+		}
+	}
+	if r.openShiftRouteEnabled(directus) && directus.Spec.Route.Host != "" {
+		return scheme(directus.Spec.Route.TLS != nil) + "://" + directus.Spec.Route.Host
+	}
+	if r.gatewayEnabled(directus) {
+		for _, hostname := range directus.Spec.Gateway.Hostnames {
+			if !strings.HasPrefix(hostname, "*") {
+				return scheme(directus.Spec.Gateway.EnableTLS) + "://" + hostname
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// ingressEnabled reports whether an Ingress exposes the instance. An enabled
+// OpenShift Route takes its place.
+func (r *DirectusReconciler) ingressEnabled(directus *directusv1.Directus) bool {
+	return directus.Spec.Ingress.Enabled && !r.openShiftRouteEnabled(directus)
+}
+
+// setIngressStatus sets IngressReady once the ingress controller admitted the
+// Ingress and all referenced TLS secrets exist
+func (r *DirectusReconciler) setIngressStatus(ctx context.Context, directus *directusv1.Directus) error {
+	if !r.ingressEnabled(directus) {
+		meta.RemoveStatusCondition(&directus.Status.Conditions, directusv1.ConditionIngressReady)
+		directus.Status.Ingress = nil
+		return nil
+	}
+
+	ingress := &networkingv1.Ingress{}
+	err := r.Get(ctx, types.NamespacedName{Name: directus.Name, Namespace: directus.Namespace}, ingress)
+	if err != nil && errors.IsNotFound(err) {
+		setCondition(directus, directusv1.ConditionIngressReady, metav1.ConditionFalse, "IngressNotFound",
+			"Ingress has not been created, at least one host is required")
+		directus.Status.Ingress = nil
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	ingressStatus := &directusv1.DirectusIngressStatus{}
+	for _, lb := range ingress.Status.LoadBalancer.Ingress {
+		if lb.IP != "" {
+			ingressStatus.Addresses = append(ingressStatus.Addresses, lb.IP)
+		}
+		if lb.Hostname != "" {
+			ingressStatus.Addresses = append(ingressStatus.Addresses, lb.Hostname)
+		}
+	}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host != "" {
+			ingressStatus.Hosts = append(ingressStatus.Hosts, rule.Host)
+		}
+	}
+	directus.Status.Ingress = ingressStatus
+
+	missing := []string{}
+	for _, tls := range ingress.Spec.TLS {
+		if tls.SecretName == "" {
+			continue
+		}
+		secret := &corev1.Secret{}
+		err := r.Get(ctx, types.NamespacedName{Name: tls.SecretName, Namespace: directus.Namespace}, secret)
+		if err != nil && errors.IsNotFound(err) {
+			missing = append(missing, tls.SecretName)
+		} else if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case len(missing) > 0:
+		setCondition(directus, directusv1.ConditionIngressReady, metav1.ConditionFalse, "TLSSecretMissing",
+			fmt.Sprintf("TLS secrets not found: %s", strings.Join(missing, ", ")))
+	case len(ingressStatus.Addresses) == 0:
+		setCondition(directus, directusv1.ConditionIngressReady, metav1.ConditionFalse, "PendingAdmission",
+			"Waiting for the ingress controller to admit the Ingress")
+	default:
+		setCondition(directus, directusv1.ConditionIngressReady, metav1.ConditionTrue, "Admitted",
+			fmt.Sprintf("Ingress admitted on %s", strings.Join(ingressStatus.Addresses, ", ")))
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// commonLabels returns the labels added to every generated object: the
+// propagated labels of the Directus resource, then spec.commonLabels
+func commonLabels(directus *directusv1.Directus) map[string]string {
+	labels := map[string]string{}
+	for _, key := range directus.Spec.PropagateLabels {
+		if value, ok := directus.Labels[key]; ok {
+			labels[key] = value
+		}
+	}
+	maps.Copy(labels, directus.Spec.CommonLabels)
+	return labels
+}
+
+// commonLabelsAnnotation and commonAnnotationsAnnotation record the keys of
+// the common labels and annotations applied to a generated object, so keys
+// removed from the spec are removed from the object as well
+const (
+	commonLabelsAnnotation      = "directus.example.com/common-labels"
+	commonAnnotationsAnnotation = "directus.example.com/common-annotations"
+)
+
+// ownedAnnotationsAnnotation records the keys of the annotations the spec
+// configures for a generated object, so keys removed from the spec are
+// removed while annotations written by other controllers are kept
+const ownedAnnotationsAnnotation = "directus.example.com/owned-annotations"
+
+// ownAnnotations returns the annotations the spec configures for a generated
+// object together with the record of their keys
+func ownAnnotations(annotations map[string]string) map[string]string {
+	return recordKeys(maps.Clone(annotations), ownedAnnotationsAnnotation, annotations)
+}
+
+// mergeOwnedMetadata adds the labels and annotations of desired to found and
+// removes the annotations applied before that desired no longer sets. Labels
+// and annotations written by other controllers are kept.
+func mergeOwnedMetadata(found, desired metav1.Object) {
+	annotations := maps.Clone(desired.GetAnnotations())
+	delete(annotations, ownedAnnotationsAnnotation)
+	stale := staleKeys(found.GetAnnotations()[ownedAnnotationsAnnotation], annotations)
+
+	found.SetLabels(mergeMetadata(found.GetLabels(), desired.GetLabels(), nil))
+	merged := mergeMetadata(found.GetAnnotations(), annotations, stale)
+	found.SetAnnotations(recordKeys(merged, ownedAnnotationsAnnotation, annotations))
+}
+
+// applyCommonMetadata adds the common labels and annotations to obj and to the
+// pod templates it contains, and removes the ones applied before that are no
+// longer configured. The operator's own labels are never replaced, as
+// selectors depend on them.
+func (r *DirectusReconciler) applyCommonMetadata(directus *directusv1.Directus, obj client.Object) {
+	labels := commonLabels(directus)
+	for key := range r.getLabels(directus) {
+		delete(labels, key)
+	}
+	annotations := directus.Spec.CommonAnnotations
+
+	applied := obj.GetAnnotations()
+	staleLabels := staleKeys(applied[commonLabelsAnnotation], labels)
+	staleAnnotations := staleKeys(applied[commonAnnotationsAnnotation], annotations)
+	if len(labels) == 0 && len(annotations) == 0 && len(staleLabels) == 0 && len(staleAnnotations) == 0 {
+		return
+	}
+
+	obj.SetLabels(mergeMetadata(obj.GetLabels(), labels, staleLabels))
+	obj.SetAnnotations(mergeMetadata(obj.GetAnnotations(), annotations, staleAnnotations))
+	obj.SetAnnotations(recordKeys(obj.GetAnnotations(), commonLabelsAnnotation, labels))
+	obj.SetAnnotations(recordKeys(obj.GetAnnotations(), commonAnnotationsAnnotation, annotations))
+
+	var templates []*metav1.ObjectMeta
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		templates = append(templates, &o.Spec.Template.ObjectMeta)
+	case *batchv1.CronJob:
+		templates = append(templates, &o.Spec.JobTemplate.ObjectMeta, &o.Spec.JobTemplate.Spec.Template.ObjectMeta)
+	}
+	for _, template := range templates {
+		template.Labels = mergeMetadata(template.Labels, labels, staleLabels)
+		template.Annotations = mergeMetadata(template.Annotations, annotations, staleAnnotations)
+	}
+}
+
+// mergeMetadata returns existing with values added and the stale keys
+// removed, leaving existing unchanged when there is nothing to do
+func mergeMetadata(existing, values map[string]string, stale []string) map[string]string {
+	if len(values) == 0 && len(stale) == 0 {
+		return existing
+	}
+	merged := maps.Clone(existing)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	for _, key := range stale {
+		delete(merged, key)
+	}
+	maps.Copy(merged, values)
+	return merged
+}
+
+// staleKeys returns the keys of a recorded list that are not in values
+func staleKeys(recorded string, values map[string]string) []string {
+	var stale []string
+	for key := range strings.SplitSeq(recorded, ",") {
+		if _, ok := values[key]; key != "" && !ok {
+			stale = append(stale, key)
+		}
+	}
+	return stale
+}
+
+// recordKeys records the sorted keys of values in the annotation key of
+// annotations, removing the annotation when there are none
+func recordKeys(annotations map[string]string, key string, values map[string]string) map[string]string {
+	if len(values) == 0 {
+		delete(annotations, key)
+		return annotations
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = strings.Join(slices.Sorted(maps.Keys(values)), ",")
+	return annotations
+}
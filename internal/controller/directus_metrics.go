@@ -0,0 +1,178 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// serviceMonitorGVK is the Prometheus Operator ServiceMonitor kind
+var serviceMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+
+// defaultMetricsPort is the service port of the metrics when none is configured
+const defaultMetricsPort int32 = 9090
+
+// ServiceMonitorsAvailable reports whether the cluster serves the Prometheus
+// Operator ServiceMonitor API. It is checked once at startup.
+func ServiceMonitorsAvailable(mapper meta.RESTMapper) (bool, error) {
+	return kindAvailable(mapper, serviceMonitorGVK)
+}
+
+// metricsEnabled reports whether Directus exposes Prometheus metrics
+func metricsEnabled(directus *directusv1.Directus) bool {
+	return directus.Spec.Metrics != nil && directus.Spec.Metrics.Enabled
+}
+
+// metricsServicePort returns the service port serving the metrics, zero when
+// there is none
+func metricsServicePort(directus *directusv1.Directus) int32 {
+	if port := directus.Spec.Service.MetricsPort; port != 0 {
+		return port
+	}
+	if metricsEnabled(directus) {
+		return defaultMetricsPort
+	}
+	return 0
+}
+
+// serviceMonitorEnabled reports whether a ServiceMonitor scrapes the
+// instance, which requires the ServiceMonitor API
+func (r *DirectusReconciler) serviceMonitorEnabled(directus *directusv1.Directus) bool {
+	if !r.ServiceMonitors || !metricsEnabled(directus) {
+		return false
+	}
+	monitor := directus.Spec.Metrics.ServiceMonitor
+	return monitor != nil && monitor.Enabled
+}
+
+// buildMetricsConfig adds the metrics settings to the ConfigMap data
+func (r *DirectusReconciler) buildMetricsConfig(directus *directusv1.Directus, data map[string]string) {
+	if !metricsEnabled(directus) {
+		return
+	}
+	metrics := directus.Spec.Metrics
+	data["METRICS_ENABLED"] = "true"
+	if len(metrics.Services) > 0 {
+		data["METRICS_SERVICES"] = strings.Join(metrics.Services, ",")
+	}
+	if metrics.Schedule != "" {
+		data["METRICS_SCHEDULE"] = metrics.Schedule
+	}
+}
+
+// buildMetricsEnvVars returns the environment variable with the token
+// scrapers authenticate with
+func (r *DirectusReconciler) buildMetricsEnvVars(directus *directusv1.Directus) []corev1.EnvVar {
+	if !metricsEnabled(directus) || directus.Spec.Metrics.TokenSecretRef == nil {
+		return nil
+	}
+	return []corev1.EnvVar{{
+		Name: "METRICS_TOKENS",
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: directus.Spec.Metrics.TokenSecretRef.DeepCopy(),
+		},
+	}}
+}
+
+// reconcileServiceMonitor creates or updates the ServiceMonitor scraping the
+// metrics port of the Directus Service, and removes it when disabled
+func (r *DirectusReconciler) reconcileServiceMonitor(ctx context.Context, directus *directusv1.Directus) error {
+	if !r.ServiceMonitors {
+		return nil
+	}
+
+	monitor := &unstructured.Unstructured{}
+	monitor.SetGroupVersionKind(serviceMonitorGVK)
+	monitor.SetName(directus.Name)
+	monitor.SetNamespace(directus.Namespace)
+
+	if !r.serviceMonitorEnabled(directus) {
+		return r.deleteChild(ctx, directus, monitor)
+	}
+
+	labels := r.getLabels(directus)
+	for key, value := range directus.Spec.Metrics.ServiceMonitor.Labels {
+		labels[key] = value
+	}
+	spec := r.buildServiceMonitorSpec(directus)
+	monitor.SetLabels(labels)
+	monitor.Object["spec"] = spec
+	if err := controllerutil.SetControllerReference(directus, monitor, r.Scheme); err != nil {
+		return err
+	}
+
+	found := &unstructured.Unstructured{}
+	found.SetGroupVersionKind(serviceMonitorGVK)
+	err := r.Get(ctx, types.NamespacedName{Name: monitor.GetName(), Namespace: directus.Namespace}, found)
+	if err != nil && errors.IsNotFound(err) {
+		return r.createChild(ctx, directus, monitor)
+	} else if err != nil {
+		return err
+	}
+
+	found.SetLabels(labels)
+	found.Object["spec"] = spec
+	return r.updateChild(ctx, directus, found)
+}
+
+// buildServiceMonitorSpec returns the spec of the ServiceMonitor
+func (r *DirectusReconciler) buildServiceMonitorSpec(directus *directusv1.Directus) map[string]any {
+	metrics := directus.Spec.Metrics
+	endpoint := map[string]any{
+		"port": "metrics",
+		"path": "/metrics",
+	}
+	if interval := metrics.ServiceMonitor.Interval; interval != "" {
+		endpoint["interval"] = interval
+	}
+	if timeout := metrics.ServiceMonitor.ScrapeTimeout; timeout != "" {
+		endpoint["scrapeTimeout"] = timeout
+	}
+	if token := metrics.TokenSecretRef; token != nil {
+		// Directus reads the token from an "Authorization: Metrics <token>" header
+		endpoint["authorization"] = map[string]any{
+			"type": "Metrics",
+			"credentials": map[string]any{
+				"name": token.Name,
+				"key":  token.Key,
+			},
+		}
+	}
+
+	matchLabels := map[string]any{}
+	for key, value := range r.getLabels(directus) {
+		matchLabels[key] = value
+	}
+	return map[string]any{
+		"endpoints": []any{endpoint},
+		"selector":  map[string]any{"matchLabels": matchLabels},
+		"namespaceSelector": map[string]any{
+			"matchNames": []any{directus.Namespace},
+		},
+	}
+}
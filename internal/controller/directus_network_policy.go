@@ -0,0 +1,317 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// namespaceNameLabel is set on every namespace by the API server
+const namespaceNameLabel = "kubernetes.io/metadata.name"
+
+// openShiftRouterNamespace runs the OpenShift router pods serving Routes
+const openShiftRouterNamespace = "openshift-ingress"
+
+// operatorPodLabels select the operator pods, which call the Directus API
+var operatorPodLabels = map[string]string{
+	"control-plane":          "controller-manager",
+	"app.kubernetes.io/name": "directus-operator",
+}
+
+func (r *DirectusReconciler) reconcileNetworkPolicy(ctx context.Context, directus *directusv1.Directus) error {
+	spec := directus.Spec.NetworkPolicy
+	if spec == nil || !spec.Enabled {
+		return r.deleteChild(ctx, directus, &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: directus.Name, Namespace: directus.Namespace},
+		})
+	}
+
+	egress, err := r.buildEgressRules(ctx, directus)
+	if err != nil {
+		return err
+	}
+
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      directus.Name,
+			Namespace: directus.Namespace,
+			Labels:    r.getLabels(directus),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: r.getLabels(directus),
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Ingress:     []networkingv1.NetworkPolicyIngressRule{r.buildIngressRule(directus)},
+			Egress:      egress,
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(directus, policy, r.Scheme); err != nil {
+		return err
+	}
+
+	found := &networkingv1.NetworkPolicy{}
+	err = r.Get(ctx, types.NamespacedName{Name: policy.Name, Namespace: policy.Namespace}, found)
+	if err != nil && errors.IsNotFound(err) {
+		return r.createChild(ctx, directus, policy)
+	} else if err != nil {
+		return err
+	}
+
+	found.Labels = policy.Labels
+	found.Spec = policy.Spec
+	return r.updateChild(ctx, directus, found)
+}
+
+// buildIngressRule allows the ingress controller, OpenShift router or Gateway
+// data plane, Prometheus, the listed namespaces and pods, and the operator to
+// reach the Directus port, which also serves the metrics
+func (r *DirectusReconciler) buildIngressRule(directus *directusv1.Directus) networkingv1.NetworkPolicyIngressRule {
+	spec := directus.Spec.NetworkPolicy
+	var from []networkingv1.NetworkPolicyPeer
+
+	if r.ingressEnabled(directus) {
+		namespace := spec.IngressControllerNamespace
+		if namespace == "" {
+			namespace = "ingress-nginx"
+		}
+		from = append(from, namespacePeer(namespace))
+	}
+	if r.openShiftRouteEnabled(directus) {
+		from = append(from, namespacePeer(openShiftRouterNamespace))
+	}
+	if r.gatewayEnabled(directus) {
+		for _, namespace := range gatewayNamespaces(directus) {
+			from = append(from, namespacePeer(namespace))
+		}
+	}
+	if metricsEnabled(directus) {
+		namespace := spec.MonitoringNamespace
+		if namespace == "" {
+			namespace = "monitoring"
+		}
+		from = append(from, namespacePeer(namespace))
+	}
+	for _, namespace := range spec.AllowedNamespaces {
+		from = append(from, namespacePeer(namespace))
+	}
+	for _, selector := range spec.AllowedPodSelectors {
+		from = append(from, networkingv1.NetworkPolicyPeer{PodSelector: selector.DeepCopy()})
+	}
+	from = append(from, networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{},
+		PodSelector:       &metav1.LabelSelector{MatchLabels: operatorPodLabels},
+	})
+
+	return networkingv1.NetworkPolicyIngressRule{
+		From:  from,
+		Ports: []networkingv1.NetworkPolicyPort{tcpPort(intstr.FromInt32(8055))},
+	}
+}
+
+// gatewayNamespaces returns the namespaces of the Gateway data plane, which
+// default to the namespaces of the parent Gateways
+func gatewayNamespaces(directus *directusv1.Directus) []string {
+	if namespaces := directus.Spec.NetworkPolicy.GatewayNamespaces; len(namespaces) > 0 {
+		return namespaces
+	}
+	var namespaces []string
+	for _, parent := range directus.Spec.Gateway.ParentRefs {
+		namespace := parent.Namespace
+		if namespace == "" {
+			namespace = directus.Namespace
+		}
+		if !slices.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
+// egressEndpoint is a server Directus connects to
+type egressEndpoint struct {
+	name string
+	host string
+	port int32
+}
+
+// egressEndpoints returns the configured database, Redis and SMTP servers
+func egressEndpoints(directus *directusv1.Directus) []egressEndpoint {
+	var endpoints []egressEndpoint
+	if database := directus.Spec.Database; database.Host != "" {
+		port := database.Port
+		if port == 0 {
+			port = defaultDatabasePort(dumpTool(database.Engine))
+		}
+		endpoints = append(endpoints, egressEndpoint{"database", database.Host, port})
+	}
+	if redis := directus.Spec.Redis; redis.Enabled && redis.Host != "" {
+		port := redis.Port
+		if port == 0 {
+			port = 6379
+		}
+		endpoints = append(endpoints, egressEndpoint{"Redis", redis.Host, port})
+	}
+	if email := directus.Spec.Email; email != nil && email.Transport == "smtp" && email.SMTP.Host != "" {
+		port := email.SMTP.Port
+		if port == 0 {
+			// The nodemailer defaults
+			port = 587
+			if email.SMTP.Secure {
+				port = 465
+			}
+		}
+		endpoints = append(endpoints, egressEndpoint{"SMTP", email.SMTP.Host, port})
+	}
+	return endpoints
+}
+
+// underivedEgressHosts returns a warning for each endpoint no egress rule can
+// be derived for, so its CIDR has to be listed in networkPolicy.egressCIDRs
+func (r *DirectusReconciler) underivedEgressHosts(ctx context.Context, directus *directusv1.Directus) []string {
+	var warnings []string
+	for _, endpoint := range egressEndpoints(directus) {
+		// Lookup errors are reported when the NetworkPolicy is reconciled
+		if rule, err := r.egressRuleFor(ctx, directus, endpoint.host, endpoint.port); err == nil && rule == nil {
+			warnings = append(warnings, fmt.Sprintf("networkPolicy cannot derive egress for %s host %s, "+
+				"add its CIDR to networkPolicy.egressCIDRs", endpoint.name, endpoint.host))
+		}
+	}
+	return warnings
+}
+
+// buildEgressRules allows DNS, the database, Redis, SMTP and the listed
+// CIDRs. Hosts no rule can be derived for are reported as spec warnings.
+func (r *DirectusReconciler) buildEgressRules(ctx context.Context, directus *directusv1.Directus) ([]networkingv1.NetworkPolicyEgressRule, error) {
+	rules := []networkingv1.NetworkPolicyEgressRule{{
+		To: []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}},
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(53))},
+			tcpPort(intstr.FromInt32(53)),
+		},
+	}}
+
+	for _, endpoint := range egressEndpoints(directus) {
+		rule, err := r.egressRuleFor(ctx, directus, endpoint.host, endpoint.port)
+		if err != nil {
+			return nil, err
+		}
+		if rule != nil {
+			rules = append(rules, *rule)
+		}
+	}
+
+	if cidrs := directus.Spec.NetworkPolicy.EgressCIDRs; len(cidrs) > 0 {
+		var to []networkingv1.NetworkPolicyPeer
+		for _, cidr := range cidrs {
+			to = append(to, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+		}
+		rules = append(rules, networkingv1.NetworkPolicyEgressRule{To: to})
+	}
+	return rules, nil
+}
+
+// egressRuleFor returns the egress rule reaching host on port. Services in
+// the cluster are matched by their pod selector and target port, as policies
+// apply to pod traffic. It returns nil for hosts outside the cluster.
+func (r *DirectusReconciler) egressRuleFor(ctx context.Context, directus *directusv1.Directus, host string, port int32) (*networkingv1.NetworkPolicyEgressRule, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		bits := 32
+		if ip.To4() == nil {
+			bits = 128
+		}
+		return &networkingv1.NetworkPolicyEgressRule{
+			To:    []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: (&net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}).String()}}},
+			Ports: []networkingv1.NetworkPolicyPort{tcpPort(intstr.FromInt32(port))},
+		}, nil
+	}
+
+	name, namespace, qualified := serviceFromHost(host, directus.Namespace)
+	if name == "" {
+		return nil, nil
+	}
+	service := &corev1.Service{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, service)
+	if errors.IsNotFound(err) {
+		// name.namespace may just as well be an external domain
+		if !qualified {
+			return nil, nil
+		}
+		service = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	peer := namespacePeer(namespace)
+	targetPort := intstr.FromInt32(port)
+	if service != nil {
+		if len(service.Spec.Selector) > 0 {
+			peer.PodSelector = &metav1.LabelSelector{MatchLabels: service.Spec.Selector}
+		}
+		for _, servicePort := range service.Spec.Ports {
+			if servicePort.Port == port && servicePort.TargetPort != (intstr.IntOrString{}) {
+				targetPort = servicePort.TargetPort
+			}
+		}
+	}
+	return &networkingv1.NetworkPolicyEgressRule{
+		To:    []networkingv1.NetworkPolicyPeer{peer},
+		Ports: []networkingv1.NetworkPolicyPort{tcpPort(targetPort)},
+	}, nil
+}
+
+// serviceFromHost returns the Service a host name refers to, e.g. "postgres",
+// "postgres.db" or "postgres.db.svc.cluster.local". Qualified is true when the
+// name can only be a Service. The name is empty for other hosts.
+func serviceFromHost(host, namespace string) (name, serviceNamespace string, qualified bool) {
+	parts := strings.Split(strings.TrimSuffix(host, "."), ".")
+	switch {
+	case len(parts) == 1:
+		return parts[0], namespace, true
+	case len(parts) == 2:
+		return parts[0], parts[1], false
+	case parts[2] == "svc":
+		return parts[0], parts[1], true
+	}
+	return "", "", false
+}
+
+func namespacePeer(namespace string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: namespace}},
+	}
+}
+
+func tcpPort(port intstr.IntOrString) networkingv1.NetworkPolicyPort {
+	return networkingv1.NetworkPolicyPort{Protocol: ptr.To(corev1.ProtocolTCP), Port: &port}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+	"github.com/example/directus-operator/internal/directusapi"
+)
+
+// directusObjectFinalizer removes the object from the Directus instance when
+// the resource managing it is deleted
+const directusObjectFinalizer = "directus.example.com/directus-object"
+
+// defaultSyncInterval is how often resources managed through the Directus API
+// are synced again, reverting changes made in the admin app
+const defaultSyncInterval = 5 * time.Minute
+
+// setSyncedCondition sets the Synced condition of a resource managed through the Directus API
+func setSyncedCondition(conditions *[]metav1.Condition, generation int64, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               directusv1.ConditionSynced,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
+
+// deleteDirectusObject deletes an object from the referenced instance. There
+// is nothing to delete when the instance itself is gone.
+func (a DirectusAPI) deleteDirectusObject(ctx context.Context, c client.Client, namespace string, ref corev1.LocalObjectReference, path, id string) error {
+	if id == "" {
+		return nil
+	}
+	_, api, err := a.connectInstance(ctx, c, namespace, ref)
+	if notReady, ok := err.(*instanceNotReadyError); ok && notReady.reason == "DirectusNotFound" {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return directusapi.DeleteItem(ctx, api, path, id)
+}
+
+// findItem returns the item with the given ID or, when there is none, the
+// first item whose field equals value. It returns nil if neither exists.
+func findItem[T any](ctx context.Context, api *directusapi.Client, path, id, field, value string) (*T, error) {
+	if id != "" {
+		item, err := directusapi.GetItem[T](ctx, api, path, id)
+		if err == nil || !directusapi.IsNotFound(err) {
+			return item, err
+		}
+	}
+	items, err := directusapi.ListItems[T](ctx, api, path, directusapi.Filter(field, value))
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+	return &items[0], nil
+}
+
+// adoptionError reports an existing object the resource may not take over
+// without spec.adopt
+type adoptionError struct {
+	kind string
+	name string
+}
+
+func (e *adoptionError) Error() string {
+	return fmt.Sprintf("%s %q already exists in Directus, set spec.adopt to manage it", e.kind, e.name)
+}
+
+// syncErrorReason returns the Synced condition reason for a failed sync
+func syncErrorReason(err error) string {
+	if _, ok := err.(*adoptionError); ok {
+		return "AlreadyExists"
+	}
+	if err == errAdminUser {
+		return "AdminUser"
+	}
+	return "APIError"
+}
+
+// checkAdoption returns an adoptionError when current is an existing object
+// other than the one recorded in the status and adopt is not set
+func checkAdoption(kind, name, currentID, statusID string, adopt bool) error {
+	if currentID != statusID && !adopt {
+		return &adoptionError{kind, name}
+	}
+	return nil
+}
+
+// syncAccess attaches the given policies to a role or a user, where owner is
+// "role" or "user". Other policies are only detached when prune is set, which
+// callers limit to objects the operator created. It returns the number of
+// changes.
+func syncAccess(ctx context.Context, api *directusapi.Client, owner, ownerID string, policyIDs []string, prune bool) (int, error) {
+	current, err := directusapi.ListItems[directusapi.Access](ctx, api, "/access", directusapi.Filter(owner, ownerID))
+	if err != nil {
+		return 0, err
+	}
+
+	changes := 0
+	attached := map[string]bool{}
+	for _, access := range current {
+		if slices.Contains(policyIDs, access.Policy) && !attached[access.Policy] {
+			attached[access.Policy] = true
+			continue
+		}
+		if !prune {
+			continue
+		}
+		if err := directusapi.DeleteItem(ctx, api, "/access", access.ID); err != nil {
+			return changes, err
+		}
+		changes++
+	}
+
+	for _, policyID := range policyIDs {
+		if attached[policyID] {
+			continue
+		}
+		access := &directusapi.Access{Policy: policyID}
+		if owner == "role" {
+			access.Role = &ownerID
+		} else {
+			access.User = &ownerID
+		}
+		if _, err := directusapi.CreateItem(ctx, api, "/access", access); err != nil {
+			return changes, err
+		}
+		attached[policyID] = true
+		changes++
+	}
+	return changes, nil
+}
+
+// rawJSON returns the JSON of an optional embedded object, nil if unset
+func rawJSON(raw *runtime.RawExtension) json.RawMessage {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil
+	}
+	return raw.Raw
+}
+
+// jsonEqual reports whether two JSON documents are semantically equal, treating
+// a missing document as null
+func jsonEqual(a, b json.RawMessage) bool {
+	var va, vb any
+	if len(a) > 0 && json.Unmarshal(a, &va) != nil {
+		return false
+	}
+	if len(b) > 0 && json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
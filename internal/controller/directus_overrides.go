@@ -0,0 +1,171 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// Override patch types
+const (
+	overrideStrategicMerge = "StrategicMerge"
+	overrideJSON           = "JSON"
+)
+
+// applyOverrides applies the spec.overrides patches for the kind of obj in
+// place, in the order they are listed. Rejected overrides are skipped, they
+// are reported as spec warnings.
+func (r *DirectusReconciler) applyOverrides(directus *directusv1.Directus, obj client.Object) error {
+	kind := r.kindOf(obj)
+	rejected := r.rejectedOverrides(directus)
+	var overrides []directusv1.DirectusOverride
+	for i, override := range directus.Spec.Overrides {
+		if _, ok := rejected[i]; !ok && override.Kind == kind {
+			overrides = append(overrides, override)
+		}
+	}
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	for i, override := range overrides {
+		switch override.Type {
+		case overrideJSON:
+			patch, err := jsonpatch.DecodePatch(override.Patch.Raw)
+			if err != nil {
+				return fmt.Errorf("decoding %s override %d: %w", kind, i, err)
+			}
+			data, err = patch.Apply(data)
+			if err != nil {
+				return fmt.Errorf("applying %s override %d: %w", kind, i, err)
+			}
+		default:
+			data, err = strategicpatch.StrategicMergePatch(data, override.Patch.Raw, obj)
+			if err != nil {
+				return fmt.Errorf("applying %s override %d: %w", kind, i, err)
+			}
+		}
+	}
+
+	// Fields removed by a patch must not survive from the rendered object
+	reflect.ValueOf(obj).Elem().SetZero()
+	return json.Unmarshal(data, obj)
+}
+
+// rejectedOverrides returns the reason each rejected override is skipped, by
+// its index in spec.overrides. Overrides are rejected when they cannot be
+// decoded or change the selectors the operator relies on to find its pods.
+func (r *DirectusReconciler) rejectedOverrides(directus *directusv1.Directus) map[int]string {
+	var labelPaths []string
+	for key := range r.getLabels(directus) {
+		labelPaths = append(labelPaths, "/spec/template/metadata/labels/"+escapeJSONPointer(key))
+	}
+	sort.Strings(labelPaths)
+	protected := map[string][]string{
+		"Deployment": append([]string{"/spec/selector"}, labelPaths...),
+		"Service":    {"/spec/selector"},
+	}
+
+	rejected := map[int]string{}
+	for i, override := range directus.Spec.Overrides {
+		paths, err := overridePaths(override)
+		if err != nil {
+			rejected[i] = err.Error()
+			continue
+		}
+	paths:
+		for _, path := range paths {
+			for _, owned := range protected[override.Kind] {
+				if pathsOverlap(path, owned) {
+					rejected[i] = fmt.Sprintf("%s %s is managed by the operator", override.Kind, owned)
+					break paths
+				}
+			}
+		}
+	}
+	return rejected
+}
+
+// overridePaths returns the JSON pointers an override may change, including
+// the source of JSON patch move and copy operations
+func overridePaths(override directusv1.DirectusOverride) ([]string, error) {
+	if override.Type == overrideJSON {
+		var operations []struct {
+			Path string `json:"path"`
+			From string `json:"from"`
+		}
+		if err := json.Unmarshal(override.Patch.Raw, &operations); err != nil {
+			return nil, fmt.Errorf("patch must be a list of JSON patch operations: %w", err)
+		}
+		paths := make([]string, 0, len(operations))
+		for _, operation := range operations {
+			paths = append(paths, operation.Path)
+			if operation.From != "" {
+				paths = append(paths, operation.From)
+			}
+		}
+		return paths, nil
+	}
+
+	var patch map[string]any
+	if err := json.Unmarshal(override.Patch.Raw, &patch); err != nil {
+		return nil, fmt.Errorf("patch must be a strategic merge patch object: %w", err)
+	}
+	var paths []string
+	collectPatchPaths("", patch, &paths)
+	return paths, nil
+}
+
+// collectPatchPaths adds the path of every value set by a strategic merge
+// patch. Directives such as $patch change the map they appear in.
+func collectPatchPaths(prefix string, patch map[string]any, paths *[]string) {
+	for key, value := range patch {
+		if strings.HasPrefix(key, "$") {
+			*paths = append(*paths, prefix)
+			continue
+		}
+		path := prefix + "/" + escapeJSONPointer(key)
+		if nested, ok := value.(map[string]any); ok {
+			collectPatchPaths(path, nested, paths)
+			continue
+		}
+		*paths = append(*paths, path)
+	}
+}
+
+// pathsOverlap reports whether changing one path may change the other
+func pathsOverlap(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+func escapeJSONPointer(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// podDisruptionBudgetEnabled reports whether the pods are protected by a
+// PodDisruptionBudget, by default only when more than one pod may run
+func podDisruptionBudgetEnabled(directus *directusv1.Directus) bool {
+	if pdb := directus.Spec.PodDisruptionBudget; pdb != nil && pdb.Enabled != nil {
+		return *pdb.Enabled
+	}
+	return directus.Spec.ReplicaCount > 1 || directus.Spec.Autoscaling.Enabled
+}
+
+func (r *DirectusReconciler) reconcilePodDisruptionBudget(ctx context.Context, directus *directusv1.Directus) error {
+	if !podDisruptionBudgetEnabled(directus) {
+		return r.deleteChild(ctx, directus, &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: directus.Name, Namespace: directus.Namespace},
+		})
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      directus.Name,
+			Namespace: directus.Namespace,
+			Labels:    r.getLabels(directus),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: r.getLabels(directus),
+			},
+			MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+		},
+	}
+	// Only one of the two may be set
+	if spec := directus.Spec.PodDisruptionBudget; spec != nil && (spec.MinAvailable != nil || spec.MaxUnavailable != nil) {
+		pdb.Spec.MinAvailable = spec.MinAvailable
+		pdb.Spec.MaxUnavailable = spec.MaxUnavailable
+	}
+
+	if err := controllerutil.SetControllerReference(directus, pdb, r.Scheme); err != nil {
+		return err
+	}
+
+	found := &policyv1.PodDisruptionBudget{}
+	err := r.Get(ctx, types.NamespacedName{Name: pdb.Name, Namespace: pdb.Namespace}, found)
+	if err != nil && errors.IsNotFound(err) {
+		return r.createChild(ctx, directus, pdb)
+	} else if err != nil {
+		return err
+	}
+
+	found.Labels = pdb.Labels
+	found.Spec.MinAvailable = pdb.Spec.MinAvailable
+	found.Spec.MaxUnavailable = pdb.Spec.MaxUnavailable
+	found.Spec.Selector = pdb.Spec.Selector
+	return r.updateChild(ctx, directus, found)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// zoneTopologyKey is the well-known node label holding the availability zone
+const zoneTopologyKey = "topology.kubernetes.io/zone"
+
+// defaultTopologySpreadConstraints spreads multiple pods across zones where
+// possible, without blocking scheduling in single-zone clusters
+func (r *DirectusReconciler) defaultTopologySpreadConstraints(directus *directusv1.Directus) []corev1.TopologySpreadConstraint {
+	if directus.Spec.ReplicaCount <= 1 && !directus.Spec.Autoscaling.Enabled {
+		return nil
+	}
+	return []corev1.TopologySpreadConstraint{{
+		MaxSkew:           1,
+		TopologyKey:       zoneTopologyKey,
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: r.getLabels(directus),
+		},
+	}}
+}
+
+// buildPodTemplate returns the pod template of the Directus pods, with the
+// sidecars and spec.podTemplate applied. The restore migration Job runs the
+// same pod, so it gets the same configuration and injected secrets.
+func (r *DirectusReconciler) buildPodTemplate(directus *directusv1.Directus) (*corev1.PodTemplateSpec, error) {
+	template := &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      r.getLabels(directus),
+			Annotations: r.getPodAnnotations(directus),
+		},
+		Spec: corev1.PodSpec{
+			ServiceAccountName: r.getServiceAccountName(directus),
+			SecurityContext:    directus.Spec.PodSecurityContext,
+			ImagePullSecrets:   directus.Spec.ImagePullSecrets,
+			InitContainers:     directus.Spec.InitContainers,
+			Containers:         append(r.buildContainers(directus), directus.Spec.Sidecars...),
+			Volumes:            directus.Spec.ExtraVolumes,
+			NodeSelector:       directus.Spec.NodeSelector,
+			Tolerations:        directus.Spec.Tolerations,
+			Affinity:           directus.Spec.Affinity,
+
+			TopologySpreadConstraints: r.defaultTopologySpreadConstraints(directus),
+		},
+	}
+
+	if err := r.applyPodTemplate(directus, template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// applyPodTemplate applies spec.podTemplate as a strategic merge patch on top
+// of the generated pod template. The selector labels are kept so the
+// Deployment continues to match its pods.
+func (r *DirectusReconciler) applyPodTemplate(directus *directusv1.Directus, template *corev1.PodTemplateSpec) error {
+	overlay := directus.Spec.PodTemplate
+	if overlay == nil || len(overlay.Raw) == 0 {
+		return nil
+	}
+
+	original, err := json.Marshal(template)
+	if err != nil {
+		return err
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, overlay.Raw, corev1.PodTemplateSpec{})
+	if err != nil {
+		return fmt.Errorf("applying spec.podTemplate: %w", err)
+	}
+	result := corev1.PodTemplateSpec{}
+	if err := json.Unmarshal(patched, &result); err != nil {
+		return fmt.Errorf("applying spec.podTemplate: %w", err)
+	}
+
+	if result.Labels == nil {
+		result.Labels = map[string]string{}
+	}
+	for key, value := range r.getLabels(directus) {
+		result.Labels[key] = value
+	}
+	*template = result
+	return nil
+}
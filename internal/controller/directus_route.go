@@ -0,0 +1,214 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// openShiftRouteGVK is the OpenShift Route kind
+var openShiftRouteGVK = schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+
+// OpenShiftRoutesAvailable reports whether the cluster serves the OpenShift
+// Route API. It is checked once at startup.
+func OpenShiftRoutesAvailable(mapper meta.RESTMapper) (bool, error) {
+	return kindAvailable(mapper, openShiftRouteGVK)
+}
+
+// kindAvailable reports whether the cluster serves a kind
+func kindAvailable(mapper meta.RESTMapper, gvk schema.GroupVersionKind) (bool, error) {
+	_, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// openShiftRouteEnabled reports whether a Route exposes the instance, which
+// requires the Route API
+func (r *DirectusReconciler) openShiftRouteEnabled(directus *directusv1.Directus) bool {
+	return r.OpenShiftRoutes && directus.Spec.Route != nil && directus.Spec.Route.Enabled
+}
+
+// reconcileOpenShiftRoute creates or updates the Route pointing at the
+// Directus Service, and removes it when the route is disabled
+func (r *DirectusReconciler) reconcileOpenShiftRoute(ctx context.Context, directus *directusv1.Directus) error {
+	if !r.OpenShiftRoutes {
+		return nil
+	}
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(openShiftRouteGVK)
+	route.SetName(directus.Name)
+	route.SetNamespace(directus.Namespace)
+
+	if !r.openShiftRouteEnabled(directus) {
+		return r.deleteChild(ctx, directus, route)
+	}
+
+	spec := buildOpenShiftRouteSpec(directus)
+	route.SetLabels(r.getLabels(directus))
+	route.SetAnnotations(ownAnnotations(directus.Spec.Route.Annotations))
+	route.Object["spec"] = spec
+	if err := controllerutil.SetControllerReference(directus, route, r.Scheme); err != nil {
+		return err
+	}
+
+	found := &unstructured.Unstructured{}
+	found.SetGroupVersionKind(openShiftRouteGVK)
+	err := r.Get(ctx, types.NamespacedName{Name: route.GetName(), Namespace: directus.Namespace}, found)
+	if err != nil && errors.IsNotFound(err) {
+		return r.createChild(ctx, directus, route)
+	} else if err != nil {
+		return err
+	}
+
+	// Keep the host OpenShift generated when none is configured
+	if host, ok, _ := unstructured.NestedString(found.Object, "spec", "host"); ok && directus.Spec.Route.Host == "" {
+		spec["host"] = host
+	}
+	// Keep the annotations OpenShift tooling writes
+	mergeOwnedMetadata(found, route)
+	found.Object["spec"] = spec
+	return r.updateChild(ctx, directus, found)
+}
+
+// buildOpenShiftRouteSpec returns the spec of the Route
+func buildOpenShiftRouteSpec(directus *directusv1.Directus) map[string]any {
+	route := directus.Spec.Route
+	spec := map[string]any{
+		"to": map[string]any{
+			"kind":   "Service",
+			"name":   directus.Name,
+			"weight": int64(100),
+		},
+		"port":           map[string]any{"targetPort": "http"},
+		"wildcardPolicy": "None",
+	}
+	if route.Host != "" {
+		spec["host"] = route.Host
+	}
+	if route.Path != "" {
+		spec["path"] = route.Path
+	}
+
+	if route.TLS != nil {
+		termination := route.TLS.Termination
+		if termination == "" {
+			termination = "edge"
+		}
+		tls := map[string]any{"termination": termination}
+		if route.TLS.InsecureEdgeTerminationPolicy != "" {
+			tls["insecureEdgeTerminationPolicy"] = route.TLS.InsecureEdgeTerminationPolicy
+		}
+		if route.TLS.ExternalCertificateSecret != "" {
+			tls["externalCertificate"] = map[string]any{"name": route.TLS.ExternalCertificateSecret}
+		}
+		if route.TLS.DestinationCACertificate != "" {
+			tls["destinationCACertificate"] = route.TLS.DestinationCACertificate
+		}
+		spec["tls"] = tls
+	}
+	return spec
+}
+
+// routeIngressStatus is the status a router reports for a Route
+type routeIngressStatus struct {
+	Host       string `json:"host,omitempty"`
+	RouterName string `json:"routerName,omitempty"`
+	Conditions []struct {
+		Type    string `json:"type"`
+		Status  string `json:"status"`
+		Reason  string `json:"reason,omitempty"`
+		Message string `json:"message,omitempty"`
+	} `json:"conditions,omitempty"`
+}
+
+// setOpenShiftRouteStatus sets RouteAdmitted and the admitted hosts from the
+// status the routers report on the Route
+func (r *DirectusReconciler) setOpenShiftRouteStatus(ctx context.Context, directus *directusv1.Directus) error {
+	if !r.openShiftRouteEnabled(directus) {
+		meta.RemoveStatusCondition(&directus.Status.Conditions, directusv1.ConditionRouteAdmitted)
+		directus.Status.Route = nil
+		return nil
+	}
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(openShiftRouteGVK)
+	err := r.Get(ctx, types.NamespacedName{Name: directus.Name, Namespace: directus.Namespace}, route)
+	if err != nil && errors.IsNotFound(err) {
+		setCondition(directus, directusv1.ConditionRouteAdmitted, metav1.ConditionFalse, "RouteNotFound",
+			"Route has not been created")
+		directus.Status.Route = nil
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var ingresses []routeIngressStatus
+	if raw, ok, _ := unstructured.NestedSlice(route.Object, "status", "ingress"); ok {
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &ingresses); err != nil {
+			return err
+		}
+	}
+
+	routeStatus := &directusv1.DirectusRouteStatus{}
+	rejected := []string{}
+	for _, ingress := range ingresses {
+		for _, condition := range ingress.Conditions {
+			if condition.Type != "Admitted" {
+				continue
+			}
+			if condition.Status == string(metav1.ConditionTrue) {
+				routeStatus.Hosts = append(routeStatus.Hosts, ingress.Host)
+			} else {
+				rejected = append(rejected, fmt.Sprintf("%s: %s", ingress.RouterName, condition.Message))
+			}
+		}
+	}
+	directus.Status.Route = routeStatus
+
+	switch {
+	case len(routeStatus.Hosts) > 0:
+		setCondition(directus, directusv1.ConditionRouteAdmitted, metav1.ConditionTrue, "Admitted",
+			fmt.Sprintf("Route admitted on %s", strings.Join(routeStatus.Hosts, ", ")))
+	case len(rejected) > 0:
+		setCondition(directus, directusv1.ConditionRouteAdmitted, metav1.ConditionFalse, "Rejected",
+			fmt.Sprintf("Route rejected by %s", strings.Join(rejected, "; ")))
+	default:
+		setCondition(directus, directusv1.ConditionRouteAdmitted, metav1.ConditionFalse, "PendingAdmission",
+			"Waiting for a router to admit the Route")
+	}
+	return nil
+}
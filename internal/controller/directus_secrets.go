@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// reconcileSecretRotation generates new KEY and SECRET values once a scheduled
+// or requested rotation is due. The rotation is recorded in the status before
+// the Secret is written, so a retry completes it instead of rotating again; the
+// Secret carries the time of the rotation it holds. The pod template records
+// the rotation time as well, so all pods are restarted with the new values in
+// one rolling update.
+func (r *DirectusReconciler) reconcileSecretRotation(ctx context.Context, directus *directusv1.Directus) error {
+	if !directus.Spec.CreateApplicationSecret {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: applicationSecretName(directus), Namespace: directus.Namespace}, secret)
+	if errors.IsNotFound(err) || (err == nil && !metav1.IsControlledBy(secret, directus)) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if due, ok := secretRotationDue(directus, now); ok && !due.After(now) {
+		directus.Status.SecretRotationTime = &metav1.Time{Time: now}
+		if err := r.Status().Update(ctx, directus); err != nil {
+			return err
+		}
+		if _, ok := directus.Annotations[directusv1.RotateSecretsAnnotation]; ok {
+			if err := r.removeAnnotation(ctx, directus, directusv1.RotateSecretsAnnotation); err != nil {
+				return err
+			}
+		}
+	}
+
+	if directus.Status.SecretRotationTime == nil {
+		return nil
+	}
+	rotatedAt := directus.Status.SecretRotationTime.UTC().Format(time.RFC3339)
+	if secret.Annotations[directusv1.SecretsRotatedAtAnnotation] == rotatedAt {
+		return nil
+	}
+	for _, key := range []string{"KEY", "SECRET"} {
+		value, err := randomToken(32)
+		if err != nil {
+			return err
+		}
+		secret.Data[key] = []byte(value)
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[directusv1.SecretsRotatedAtAnnotation] = rotatedAt
+	if err := r.Update(ctx, secret); err != nil {
+		return err
+	}
+	r.recordEvent(directus, corev1.EventTypeNormal, ReasonSecretsRotated, "Rotated KEY and SECRET in Secret %s", secret.Name)
+	return nil
+}
+
+// secretRotationDue returns when KEY and SECRET are rotated next, false when
+// no rotation is requested or scheduled
+func secretRotationDue(directus *directusv1.Directus, now time.Time) (time.Time, bool) {
+	rotation := directus.Spec.SecretRotation
+	var window *directusv1.DirectusMaintenanceWindow
+	if rotation != nil {
+		window = rotation.MaintenanceWindow
+	}
+
+	if _, ok := directus.Annotations[directusv1.RotateSecretsAnnotation]; ok {
+		return maintenanceWindowStart(window, now), true
+	}
+	if rotation == nil || rotation.Interval == nil {
+		return time.Time{}, false
+	}
+
+	last := directus.CreationTimestamp.Time
+	if directus.Status.SecretRotationTime != nil {
+		last = directus.Status.SecretRotationTime.Time
+	}
+	due := last.Add(rotation.Interval.Duration)
+	// A window missed while the operator was down is not made up outside the window
+	if due.Before(now) {
+		due = now
+	}
+	return maintenanceWindowStart(window, due), true
+}
+
+// maintenanceWindowStart returns the earliest time at or after t inside the window
+func maintenanceWindowStart(window *directusv1.DirectusMaintenanceWindow, t time.Time) time.Time {
+	if window == nil || window.Duration.Duration <= 0 {
+		return t
+	}
+	start, err := time.Parse("15:04", window.Start)
+	if err != nil {
+		return t
+	}
+
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), start.Hour(), start.Minute(), 0, 0, time.UTC)
+	// Start the day before, a window may extend past midnight
+	for i := -1; i <= 7; i++ {
+		opens := day.AddDate(0, 0, i)
+		if len(window.Days) > 0 && !slices.Contains(window.Days, opens.Weekday().String()[:3]) {
+			continue
+		}
+		if t.Before(opens.Add(window.Duration.Duration)) {
+			if t.Before(opens) {
+				return opens
+			}
+			return t
+		}
+	}
+	return t
+}
+
+// getPodAnnotations returns the pod template annotations, including the last
+// rotation of KEY and SECRET and the Vault Agent injector configuration
+func (r *DirectusReconciler) getPodAnnotations(directus *directusv1.Directus) map[string]string {
+	vault := directus.Spec.SecretsFrom != nil && directus.Spec.SecretsFrom.Vault != nil
+	if directus.Status.SecretRotationTime == nil && !vault {
+		return directus.Spec.PodAnnotations
+	}
+	annotations := maps.Clone(directus.Spec.PodAnnotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if directus.Status.SecretRotationTime != nil {
+		annotations[directusv1.SecretsRotatedAtAnnotation] = directus.Status.SecretRotationTime.UTC().Format(time.RFC3339)
+	}
+	if vault {
+		maps.Copy(annotations, vaultAnnotations(directus.Spec.SecretsFrom.Vault))
+	}
+	return annotations
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// validateSpec returns warnings for configurations that are accepted but
+// unlikely to work as intended
+func (r *DirectusReconciler) validateSpec(ctx context.Context, directus *directusv1.Directus) []string {
+	warnings := []string{}
+
+	multiReplica := directus.Spec.ReplicaCount > 1 ||
+		(directus.Spec.Autoscaling.Enabled && directus.Spec.Autoscaling.MaxReplicas > 1)
+	if multiReplica && !directus.Spec.Redis.Enabled {
+		warnings = append(warnings, "multiple replicas are configured without a shared Redis; "+
+			"cache, rate limiter, session and synchronization state will not be shared between pods")
+	}
+
+	if backup := directus.Spec.Backup; backup != nil && backup.Enabled {
+		if dumpTool(directus.Spec.Database.Engine) == "" {
+			warnings = append(warnings, "backups are enabled but database engine \""+directus.Spec.Database.Engine+
+				"\" is not supported; only PostgreSQL and MySQL can be backed up")
+		}
+		if backup.Storage.PersistentVolumeClaim == "" && backup.Storage.S3 == nil {
+			warnings = append(warnings, "backups are enabled but no storage target is configured")
+		}
+	}
+
+	if directus.Spec.SecretsFrom != nil && directus.Spec.CreateApplicationSecret {
+		warnings = append(warnings, "createApplicationSecret is ignored because the credentials are read from spec.secretsFrom")
+	}
+
+	if directus.Spec.SecretRotation != nil && (!directus.Spec.CreateApplicationSecret || directus.Spec.SecretsFrom != nil) {
+		warnings = append(warnings, "secret rotation is configured but only applies to the application secret "+
+			"created with createApplicationSecret")
+	}
+
+	if ingress := directus.Spec.Ingress; r.ingressEnabled(directus) && ingress.Certificate != nil {
+		switch {
+		case !r.Certificates:
+			warnings = append(warnings, "ingress.certificate is ignored because the cert-manager Certificate kind was not found at startup")
+		case len(certificateHosts(directus)) == 0:
+			warnings = append(warnings, "ingress.certificate is ignored because no ingress host is set to issue it for")
+		case len(ingress.TLS) > 0:
+			warnings = append(warnings, "ingress.tls is ignored because the TLS configuration is derived from ingress.certificate")
+		}
+	}
+
+	if gateway := directus.Spec.Gateway; gateway != nil && gateway.Enabled && !r.HTTPRoutes {
+		warnings = append(warnings, "gateway is ignored because the Gateway API HTTPRoute kind was not found at startup")
+	}
+
+	if route := directus.Spec.Route; route != nil && route.Enabled {
+		if !r.OpenShiftRoutes {
+			warnings = append(warnings, "route is ignored because the OpenShift Route API was not found at startup")
+		} else if directus.Spec.Ingress.Enabled {
+			warnings = append(warnings, "ingress is ignored because the OpenShift Route replaces it; disable one of them")
+		}
+	}
+
+	if metrics := directus.Spec.Metrics; metrics != nil && metrics.ServiceMonitor != nil && metrics.ServiceMonitor.Enabled {
+		if !r.ServiceMonitors {
+			warnings = append(warnings, "metrics.serviceMonitor is ignored because the ServiceMonitor API was not found at startup")
+		} else if metrics.TokenSecretRef == nil {
+			warnings = append(warnings, "metrics.serviceMonitor is set without metrics.tokenSecretRef; "+
+				"Directus rejects the scrapes as only admins may read the metrics without a token")
+		}
+	}
+
+	if policy := directus.Spec.NetworkPolicy; policy != nil && policy.Enabled {
+		warnings = append(warnings, r.underivedEgressHosts(ctx, directus)...)
+	}
+
+	rejected := r.rejectedOverrides(directus)
+	for _, i := range slices.Sorted(maps.Keys(rejected)) {
+		warnings = append(warnings, fmt.Sprintf("spec.overrides[%d] is ignored: %s", i, rejected[i]))
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(directus.Spec.CommonLabels)) {
+		if _, ok := r.getLabels(directus)[key]; ok {
+			warnings = append(warnings, "common label \""+key+"\" is ignored because the operator manages it")
+		}
+	}
+
+	return warnings
+}
+
+// setSpecWarnings reports the warnings in the SpecWarnings condition. Events
+// are only recorded for warnings the condition did not report yet, so an
+// unchanged spec does not repeat them on every reconcile.
+func (r *DirectusReconciler) setSpecWarnings(directus *directusv1.Directus, warnings []string) {
+	if len(warnings) == 0 {
+		setCondition(directus, directusv1.ConditionSpecWarnings, metav1.ConditionFalse, "NoWarnings",
+			"The spec has no warnings")
+		return
+	}
+
+	var previous []string
+	if condition := meta.FindStatusCondition(directus.Status.Conditions, directusv1.ConditionSpecWarnings); condition != nil &&
+		condition.Status == metav1.ConditionTrue {
+		previous = strings.Split(condition.Message, "\n")
+	}
+	for _, warning := range warnings {
+		if !slices.Contains(previous, warning) {
+			r.recordEvent(directus, corev1.EventTypeWarning, ReasonSpecWarning, "%s", warning)
+		}
+	}
+	setCondition(directus, directusv1.ConditionSpecWarnings, metav1.ConditionTrue, "SpecWarning",
+		strings.Join(warnings, "\n"))
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// DirectusBackupReconciler reconciles a DirectusBackup object
+type DirectusBackupReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusbackups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusbackups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusbackups/finalizers,verbs=update
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile runs a one-off backup Job for the DirectusBackup and reports its
+// result. Finished backups are never run again.
+func (r *DirectusBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	var backup directusv1.DirectusBackup
+	if err := r.Get(ctx, req.NamespacedName, &backup); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if backup.Status.Phase == directusv1.PhaseSucceeded || backup.Status.Phase == directusv1.PhaseFailed {
+		return ctrl.Result{}, nil
+	}
+
+	jobName := backup.Name + "-backup"
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: jobName, Namespace: backup.Namespace}, job)
+	if err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	if errors.IsNotFound(err) {
+		var directus directusv1.Directus
+		directusKey := types.NamespacedName{Name: backup.Spec.DirectusRef.Name, Namespace: backup.Namespace}
+		if err := r.Get(ctx, directusKey, &directus); err != nil {
+			if errors.IsNotFound(err) {
+				return r.setPhase(ctx, &backup, directusv1.PhasePending,
+					fmt.Sprintf("Directus %s not found", directusKey.Name), 30*time.Second)
+			}
+			return ctrl.Result{}, err
+		}
+		applyDefaults(&directus)
+
+		podSpec, err := buildBackupPodSpec(&directus, backupOptions{
+			Snapshot:         backup.Name,
+			Image:            backup.Spec.Image,
+			UploadsClaimName: backup.Spec.UploadsClaimName,
+			Storage:          backup.Spec.Storage,
+			Resources:        backup.Spec.Resources,
+		})
+		if err != nil {
+			r.Recorder.Eventf(&backup, corev1.EventTypeWarning, "InvalidSpec", "%v", err)
+			return r.setPhase(ctx, &backup, directusv1.PhaseFailed, err.Error(), 0)
+		}
+
+		if job, err = r.createJob(ctx, &backup, &directus, jobName, podSpec); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("Created backup Job", "job", job.Name)
+		r.Recorder.Eventf(&backup, corev1.EventTypeNormal, ReasonCreated, "Created Job %s", job.Name)
+
+		backup.Status.JobName = job.Name
+		backup.Status.StartTime = ptr.To(metav1.Now())
+		return r.setPhase(ctx, &backup, directusv1.PhaseRunning, "Backup Job is running", 0)
+	}
+
+	// The status update may have failed after the Job was created
+	if backup.Status.JobName == "" {
+		backup.Status.JobName = job.Name
+		backup.Status.StartTime = &job.CreationTimestamp
+	}
+
+	switch {
+	case jobSucceeded(job):
+		result, err := backupResult(ctx, r.Client, job)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		backup.Status.DirectusBackupResult = *result
+		r.Recorder.Eventf(&backup, corev1.EventTypeNormal, "BackupSucceeded", "Backup stored at %s", result.Location)
+		return r.setPhase(ctx, &backup, directusv1.PhaseSucceeded, "Backup completed", 0)
+	case jobFailed(job):
+		r.Recorder.Eventf(&backup, corev1.EventTypeWarning, "BackupFailed", "Backup Job %s failed", job.Name)
+		return r.setPhase(ctx, &backup, directusv1.PhaseFailed, fmt.Sprintf("Backup Job %s failed", job.Name), 0)
+	}
+	return r.setPhase(ctx, &backup, directusv1.PhaseRunning, "Backup Job is running", 0)
+}
+
+// createJob creates the backup Job owned by the DirectusBackup
+func (r *DirectusBackupReconciler) createJob(ctx context.Context, backup *directusv1.DirectusBackup, directus *directusv1.Directus, name string, podSpec *corev1.PodSpec) (*batchv1.Job, error) {
+	labels := backupLabels(directus)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: backup.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](2),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       *podSpec,
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(backup, job, r.Scheme); err != nil {
+		return nil, err
+	}
+	return job, r.Create(ctx, job)
+}
+
+// setPhase updates the phase and message of the backup status
+func (r *DirectusBackupReconciler) setPhase(ctx context.Context, backup *directusv1.DirectusBackup, phase, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	backup.Status.Phase = phase
+	backup.Status.Message = message
+	if err := r.Status().Update(ctx, backup); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DirectusBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&directusv1.DirectusBackup{}).
+		Owns(&batchv1.Job{}).
+		Named("directusbackup").
+		Complete(r)
+}
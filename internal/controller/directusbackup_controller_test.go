@@ -0,0 +1,119 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+var _ = Describe("DirectusBackup Controller", func() {
+	Context("When reconciling a resource", func() {
+		const directusName = "snapshot-directus"
+		const resourceName = "snapshot"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			backup := &directusv1.DirectusBackup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusBackupSpec{
+					DirectusRef: corev1.LocalObjectReference{Name: directusName},
+					Storage:     directusv1.DirectusBackupStorage{PersistentVolumeClaim: "backups"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, backup)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			backup := &directusv1.DirectusBackup{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, backup)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, backup)).To(Succeed())
+
+			directus := &directusv1.Directus{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Name: directusName, Namespace: "default"}, directus); err == nil {
+				Expect(k8sClient.Delete(ctx, directus)).To(Succeed())
+			}
+		})
+
+		It("should wait for the Directus instance and then run the backup Job", func() {
+			controllerReconciler := &DirectusBackupReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).NotTo(BeZero())
+
+			backup := &directusv1.DirectusBackup{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, backup)).To(Succeed())
+			Expect(backup.Status.Phase).To(Equal(directusv1.PhasePending))
+
+			By("creating the Directus instance")
+			directus := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      directusName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					Database: directusv1.DirectusDatabase{
+						Engine:   "postgresql",
+						Host:     "postgresql",
+						Database: "directus",
+						Username: "directus",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, directus)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, backup)).To(Succeed())
+			Expect(backup.Status.Phase).To(Equal(directusv1.PhaseRunning))
+			Expect(backup.Status.JobName).To(Equal(resourceName + "-backup"))
+
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: backup.Status.JobName, Namespace: "default"}, job)).To(Succeed())
+			Expect(metav1.IsControlledBy(job, backup)).To(BeTrue())
+			podSpec := job.Spec.Template.Spec
+			Expect(podSpec.InitContainers[0].Env).To(ContainElement(corev1.EnvVar{Name: "BACKUP_NAME", Value: resourceName}))
+			Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "BACKUP_DIR", Value: directusName + "/snapshots"}))
+			Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "KEEP_LAST", Value: "0"}))
+		})
+	})
+})
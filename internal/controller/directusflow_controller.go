@@ -0,0 +1,346 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+	"github.com/example/directus-operator/internal/directusapi"
+)
+
+// flowIDNamespace is the UUID namespace flow IDs are derived in, so that a
+// DirectusFlow always maps to the same flow even without its status
+var flowIDNamespace = uuid.MustParse("5b1f0d52-8c1e-4c7a-9a51-6a0d7f3c2e41")
+
+// DirectusFlowReconciler reconciles a DirectusFlow object
+type DirectusFlowReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	API      DirectusAPI
+}
+
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusflows,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusflows/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusflows/finalizers,verbs=update
+// +kubebuilder:rbac:groups=directus.example.com,resources=directuses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// Reconcile creates or updates the flow and its operations in the referenced
+// instance. Flow and operation IDs are derived from the resource and the
+// operation keys. Changes made in the admin app are reverted or reported
+// according to the drift policy, spec changes are always applied.
+func (r *DirectusFlowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var flow directusv1.DirectusFlow
+	if err := r.Get(ctx, req.NamespacedName, &flow); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !flow.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&flow, directusObjectFinalizer) {
+			return ctrl.Result{}, nil
+		}
+		if err := r.API.deleteDirectusObject(ctx, r.Client, flow.Namespace, flow.Spec.DirectusRef, "/flows", flow.Status.ID); err != nil {
+			return ctrl.Result{}, err
+		}
+		controllerutil.RemoveFinalizer(&flow, directusObjectFinalizer)
+		return ctrl.Result{}, r.Update(ctx, &flow)
+	}
+
+	if controllerutil.AddFinalizer(&flow, directusObjectFinalizer) {
+		if err := r.Update(ctx, &flow); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	desired, desiredOperations, err := buildFlow(&flow)
+	if err != nil {
+		setSyncedCondition(&flow.Status.Conditions, flow.Generation, metav1.ConditionFalse, "InvalidSpec", err.Error())
+		return ctrl.Result{}, r.Status().Update(ctx, &flow)
+	}
+
+	_, api, err := r.API.connectInstance(ctx, r.Client, flow.Namespace, flow.Spec.DirectusRef)
+	if notReady, ok := err.(*instanceNotReadyError); ok {
+		setSyncedCondition(&flow.Status.Conditions, flow.Generation, metav1.ConditionUnknown, notReady.reason, notReady.message)
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, r.Status().Update(ctx, &flow)
+	} else if err != nil {
+		return ctrl.Result{}, r.reportError(ctx, &flow, err)
+	}
+
+	current, err := directusapi.GetItem[directusapi.Flow](ctx, api, "/flows", desired.ID)
+	if directusapi.IsNotFound(err) {
+		current, err = nil, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, r.reportError(ctx, &flow, err)
+	}
+	var currentOperations []directusapi.Operation
+	if current != nil {
+		currentOperations, err = directusapi.ListItems[directusapi.Operation](ctx, api, "/operations", directusapi.Filter("flow", desired.ID))
+		if err != nil {
+			return ctrl.Result{}, r.reportError(ctx, &flow, err)
+		}
+	}
+	flow.Status.ID = desired.ID
+
+	drift := flowDrift(current, currentOperations, desired, desiredOperations)
+	specChanged := flow.Status.ObservedGeneration != flow.Generation
+	if len(drift) > 0 && !specChanged && current != nil && flow.Spec.DriftPolicy == directusv1.DriftPolicyReport {
+		if len(flow.Status.Drift) == 0 {
+			r.Recorder.Eventf(&flow, corev1.EventTypeWarning, "DriftDetected", "Flow changed in Directus: %v", drift)
+		}
+		flow.Status.Drift = drift
+		setSyncedCondition(&flow.Status.Conditions, flow.Generation, metav1.ConditionFalse, "DriftDetected",
+			fmt.Sprintf("%d changes made in Directus", len(drift)))
+		return ctrl.Result{RequeueAfter: defaultSyncInterval}, r.Status().Update(ctx, &flow)
+	}
+
+	if len(drift) > 0 {
+		if err := applyFlow(ctx, api, current, currentOperations, desired, desiredOperations); err != nil {
+			return ctrl.Result{}, r.reportError(ctx, &flow, err)
+		}
+		logf.FromContext(ctx).Info("Applied flow", "id", desired.ID, "changes", drift)
+		r.Recorder.Eventf(&flow, corev1.EventTypeNormal, "Synced", "Applied %d changes to flow %s", len(drift), desired.ID)
+	}
+
+	flow.Status.Drift = nil
+	flow.Status.ObservedGeneration = flow.Generation
+	setSyncedCondition(&flow.Status.Conditions, flow.Generation, metav1.ConditionTrue, "Synced", "Flow matches the resource")
+	return ctrl.Result{RequeueAfter: defaultSyncInterval}, r.Status().Update(ctx, &flow)
+}
+
+// buildFlow returns the desired flow and operations, validating the operation references
+func buildFlow(flow *directusv1.DirectusFlow) (directusapi.Flow, []directusapi.Operation, error) {
+	spec := flow.Spec
+	id := uuid.NewSHA1(flowIDNamespace, []byte(flow.Namespace+"/"+flow.Name)).String()
+
+	operationIDs := map[string]*string{}
+	for _, operation := range spec.Operations {
+		if _, ok := operationIDs[operation.Key]; ok {
+			return directusapi.Flow{}, nil, fmt.Errorf("duplicate operation key %q", operation.Key)
+		}
+		operationIDs[operation.Key] = ptr.To(uuid.NewSHA1(uuid.MustParse(id), []byte(operation.Key)).String())
+	}
+	reference := func(key string) (*string, error) {
+		if key == "" {
+			return nil, nil
+		}
+		if operationID, ok := operationIDs[key]; ok {
+			return operationID, nil
+		}
+		return nil, fmt.Errorf("operation %q is not defined", key)
+	}
+
+	desired := directusapi.Flow{
+		ID:             id,
+		Name:           spec.Name,
+		Icon:           spec.Icon,
+		Color:          spec.Color,
+		Description:    spec.Description,
+		Status:         "active",
+		Trigger:        spec.Trigger,
+		Accountability: ptr.To("all"),
+		Options:        rawJSON(spec.Options),
+	}
+	if desired.Name == "" {
+		desired.Name = flow.Name
+	}
+	if spec.Active != nil && !*spec.Active {
+		desired.Status = "inactive"
+	}
+	switch spec.Accountability {
+	case "none":
+		desired.Accountability = nil
+	case "activity":
+		desired.Accountability = ptr.To("activity")
+	}
+	var err error
+	if desired.Operation, err = reference(spec.Operation); err != nil {
+		return desired, nil, err
+	}
+
+	var operations []directusapi.Operation
+	for i, spec := range spec.Operations {
+		operation := directusapi.Operation{
+			ID:        *operationIDs[spec.Key],
+			Flow:      id,
+			Name:      spec.Name,
+			Key:       spec.Key,
+			Type:      spec.Type,
+			PositionX: spec.PositionX,
+			PositionY: spec.PositionY,
+			Options:   rawJSON(spec.Options),
+		}
+		if operation.Name == "" {
+			operation.Name = spec.Key
+		}
+		// Lay out operations left to right on the editor grid by default
+		if operation.PositionX == 0 {
+			operation.PositionX = int32(19 + 18*i)
+		}
+		if operation.PositionY == 0 {
+			operation.PositionY = 1
+		}
+		if operation.Resolve, err = reference(spec.Resolve); err != nil {
+			return desired, nil, err
+		}
+		if operation.Reject, err = reference(spec.Reject); err != nil {
+			return desired, nil, err
+		}
+		operations = append(operations, operation)
+	}
+	return desired, operations, nil
+}
+
+// flowDrift lists the differences between the flow in Directus and the desired flow
+func flowDrift(current *directusapi.Flow, currentOperations []directusapi.Operation, desired directusapi.Flow, desiredOperations []directusapi.Operation) []string {
+	if current == nil {
+		return []string{"flow does not exist"}
+	}
+
+	var drift []string
+	if !flowEqual(current, &desired) {
+		drift = append(drift, "flow settings changed")
+	}
+
+	existing := map[string]directusapi.Operation{}
+	for _, operation := range currentOperations {
+		existing[operation.ID] = operation
+	}
+	for _, operation := range desiredOperations {
+		found, ok := existing[operation.ID]
+		delete(existing, operation.ID)
+		if !ok {
+			drift = append(drift, fmt.Sprintf("operation %s is missing", operation.Key))
+		} else if !operationEqual(&found, &operation) {
+			drift = append(drift, fmt.Sprintf("operation %s changed", operation.Key))
+		}
+	}
+	for _, operation := range existing {
+		drift = append(drift, fmt.Sprintf("operation %s is not managed", operation.Key))
+	}
+	return drift
+}
+
+// applyFlow makes the flow and its operations match the desired state.
+// Operations are created before they are linked, as links reference other
+// operations, and unmanaged operations are deleted first to free their keys.
+func applyFlow(ctx context.Context, api *directusapi.Client, current *directusapi.Flow, currentOperations []directusapi.Operation, desired directusapi.Flow, desiredOperations []directusapi.Operation) error {
+	unlinked := desired
+	unlinked.Operation = nil
+	if current == nil {
+		if _, err := directusapi.CreateItem(ctx, api, "/flows", &unlinked); err != nil {
+			return err
+		}
+	} else if _, err := directusapi.UpdateItem[directusapi.Flow](ctx, api, "/flows", desired.ID, &unlinked); err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	for _, operation := range currentOperations {
+		existing[operation.ID] = true
+	}
+	desiredIDs := map[string]bool{}
+	for _, operation := range desiredOperations {
+		desiredIDs[operation.ID] = true
+	}
+
+	for _, operation := range currentOperations {
+		if !desiredIDs[operation.ID] {
+			if err := directusapi.DeleteItem(ctx, api, "/operations", operation.ID); err != nil {
+				return err
+			}
+		}
+	}
+	for _, operation := range desiredOperations {
+		if existing[operation.ID] {
+			continue
+		}
+		operation.Resolve, operation.Reject = nil, nil
+		if _, err := directusapi.CreateItem(ctx, api, "/operations", &operation); err != nil {
+			return fmt.Errorf("failed to create operation %s: %w", operation.Key, err)
+		}
+	}
+	for _, operation := range desiredOperations {
+		if _, err := directusapi.UpdateItem[directusapi.Operation](ctx, api, "/operations", operation.ID, &operation); err != nil {
+			return fmt.Errorf("failed to update operation %s: %w", operation.Key, err)
+		}
+	}
+
+	_, err := directusapi.UpdateItem[directusapi.Flow](ctx, api, "/flows", desired.ID, map[string]*string{"operation": desired.Operation})
+	return err
+}
+
+// reportError marks the flow as not synced and returns err for a retry
+func (r *DirectusFlowReconciler) reportError(ctx context.Context, flow *directusv1.DirectusFlow, err error) error {
+	r.Recorder.Eventf(flow, corev1.EventTypeWarning, "SyncFailed", "%v", err)
+	setSyncedCondition(&flow.Status.Conditions, flow.Generation, metav1.ConditionFalse, "APIError", err.Error())
+	if statusErr := r.Status().Update(ctx, flow); statusErr != nil {
+		logf.FromContext(ctx).Error(statusErr, "Failed to update DirectusFlow status")
+	}
+	return err
+}
+
+// flowEqual compares the managed fields, Directus sets a default icon when none is given
+func flowEqual(a, b *directusapi.Flow) bool {
+	return a.Name == b.Name && a.Description == b.Description && a.Status == b.Status && a.Trigger == b.Trigger &&
+		(b.Icon == "" || a.Icon == b.Icon) && (b.Color == "" || a.Color == b.Color) &&
+		ptr.Deref(a.Accountability, "") == ptr.Deref(b.Accountability, "") &&
+		ptr.Deref(a.Operation, "") == ptr.Deref(b.Operation, "") &&
+		optionsEqual(a.Options, b.Options)
+}
+
+func operationEqual(a, b *directusapi.Operation) bool {
+	return a.Name == b.Name && a.Key == b.Key && a.Type == b.Type &&
+		a.PositionX == b.PositionX && a.PositionY == b.PositionY &&
+		ptr.Deref(a.Resolve, "") == ptr.Deref(b.Resolve, "") &&
+		ptr.Deref(a.Reject, "") == ptr.Deref(b.Reject, "") &&
+		optionsEqual(a.Options, b.Options)
+}
+
+// optionsEqual compares option objects, treating null and {} alike
+func optionsEqual(a, b []byte) bool {
+	empty := func(raw []byte) bool {
+		return len(raw) == 0 || jsonEqual(raw, []byte("null")) || jsonEqual(raw, []byte("{}"))
+	}
+	if empty(a) || empty(b) {
+		return empty(a) && empty(b)
+	}
+	return jsonEqual(a, b)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DirectusFlowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&directusv1.DirectusFlow{}).
+		Named("directusflow").
+		Complete(r)
+}
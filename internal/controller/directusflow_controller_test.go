@@ -0,0 +1,190 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+var _ = Describe("DirectusFlow Controller", func() {
+	Context("When reconciling a resource", func() {
+		const directusName = "flow-directus"
+		const resourceName = "notify-on-publish"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createRunningDirectus(ctx, directusName)
+
+			flow := &directusv1.DirectusFlow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusFlowSpec{
+					DirectusRef: corev1.LocalObjectReference{Name: directusName},
+					Name:        "Notify on publish",
+					Trigger:     "event",
+					Options:     &runtime.RawExtension{Raw: []byte(`{"type":"action","scope":["items.update"]}`)},
+					Operation:   "check",
+					Operations: []directusv1.DirectusFlowOperation{
+						{Key: "check", Type: "condition", Resolve: "notify"},
+						{Key: "notify", Type: "log", Options: &runtime.RawExtension{Raw: []byte(`{"message":"published"}`)}},
+					},
+					DriftPolicy: directusv1.DriftPolicyReport,
+				},
+			}
+			Expect(k8sClient.Create(ctx, flow)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			flow := &directusv1.DirectusFlow{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, flow)).To(Succeed())
+			flow.Finalizers = nil
+			Expect(k8sClient.Update(ctx, flow)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, flow)).To(Succeed())
+			deleteDirectus(ctx, directusName)
+		})
+
+		It("should create the flow and report later changes", func() {
+			var mu sync.Mutex
+			flows := map[string]map[string]any{}
+			operations := map[string]map[string]any{}
+			item := func(items map[string]map[string]any) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					mu.Lock()
+					defer mu.Unlock()
+					id := r.PathValue("id")
+					if r.Method != http.MethodPost && items[id] == nil {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					if r.Method != http.MethodGet {
+						var body map[string]any
+						Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+						if r.Method == http.MethodPost {
+							id = body["id"].(string)
+							items[id] = map[string]any{}
+						}
+						for key, value := range body {
+							items[id][key] = value
+						}
+					}
+					_ = json.NewEncoder(w).Encode(map[string]any{"data": items[id]})
+				}
+			}
+			server, mux := newFakeDirectus()
+			defer server.Close()
+			mux.HandleFunc("GET /flows/{id}", item(flows))
+			mux.HandleFunc("POST /flows", item(flows))
+			mux.HandleFunc("PATCH /flows/{id}", item(flows))
+			mux.HandleFunc("POST /operations", item(operations))
+			mux.HandleFunc("PATCH /operations/{id}", item(operations))
+			mux.HandleFunc("GET /operations", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				data := []map[string]any{}
+				for _, operation := range operations {
+					if operation["flow"] == r.URL.Query().Get("filter[flow][_eq]") {
+						data = append(data, operation)
+					}
+				}
+				_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+			})
+
+			recorder := record.NewFakeRecorder(10)
+			controllerReconciler := &DirectusFlowReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: recorder,
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			flow := &directusv1.DirectusFlow{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, flow)).To(Succeed())
+			Expect(flow.Status.ID).NotTo(BeEmpty())
+			Expect(meta.IsStatusConditionTrue(flow.Status.Conditions, directusv1.ConditionSynced)).To(BeTrue())
+
+			Expect(flows).To(HaveKey(flow.Status.ID))
+			Expect(operations).To(HaveLen(2))
+			var check, notify map[string]any
+			for _, operation := range operations {
+				switch operation["key"] {
+				case "check":
+					check = operation
+				case "notify":
+					notify = operation
+				}
+			}
+			Expect(flows[flow.Status.ID]).To(HaveKeyWithValue("operation", check["id"]))
+			Expect(check).To(HaveKeyWithValue("resolve", notify["id"]))
+			Expect(notify).To(HaveKeyWithValue("options", HaveKeyWithValue("message", "published")))
+
+			By("changing an operation in the admin app")
+			mu.Lock()
+			notify["options"] = map[string]any{"message": "edited"}
+			mu.Unlock()
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, flow)).To(Succeed())
+			Expect(flow.Status.Drift).To(ConsistOf("operation notify changed"))
+			synced := meta.FindStatusCondition(flow.Status.Conditions, directusv1.ConditionSynced)
+			Expect(synced).NotTo(BeNil())
+			Expect(synced.Reason).To(Equal("DriftDetected"))
+			Expect(notify).To(HaveKeyWithValue("options", HaveKeyWithValue("message", "edited")))
+
+			By("reverting the change once the policy allows it")
+			flow.Spec.DriftPolicy = directusv1.DriftPolicyRevert
+			Expect(k8sClient.Update(ctx, flow)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, flow)).To(Succeed())
+			Expect(flow.Status.Drift).To(BeEmpty())
+			Expect(meta.IsStatusConditionTrue(flow.Status.Conditions, directusv1.ConditionSynced)).To(BeTrue())
+			Expect(notify).To(HaveKeyWithValue("options", HaveKeyWithValue("message", "published")))
+		})
+	})
+})
@@ -0,0 +1,246 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+	"github.com/example/directus-operator/internal/directusapi"
+)
+
+// DirectusPolicyReconciler reconciles a DirectusPolicy object
+type DirectusPolicyReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	API      DirectusAPI
+}
+
+// +kubebuilder:rbac:groups=directus.example.com,resources=directuspolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=directus.example.com,resources=directuspolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directuspolicies/finalizers,verbs=update
+// +kubebuilder:rbac:groups=directus.example.com,resources=directuses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// Reconcile creates or updates the policy and its permissions in the
+// referenced instance, and deletes the policy when the resource is deleted.
+func (r *DirectusPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var policy directusv1.DirectusPolicy
+	if err := r.Get(ctx, req.NamespacedName, &policy); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !policy.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&policy, directusObjectFinalizer) {
+			return ctrl.Result{}, nil
+		}
+		// Adopted policies existed before the resource and are kept
+		if policy.Status.Created {
+			if err := r.API.deleteDirectusObject(ctx, r.Client, policy.Namespace, policy.Spec.DirectusRef, "/policies", policy.Status.ID); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		controllerutil.RemoveFinalizer(&policy, directusObjectFinalizer)
+		return ctrl.Result{}, r.Update(ctx, &policy)
+	}
+
+	if controllerutil.AddFinalizer(&policy, directusObjectFinalizer) {
+		if err := r.Update(ctx, &policy); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	_, api, err := r.API.connectInstance(ctx, r.Client, policy.Namespace, policy.Spec.DirectusRef)
+	if notReady, ok := err.(*instanceNotReadyError); ok {
+		setSyncedCondition(&policy.Status.Conditions, policy.Generation, metav1.ConditionUnknown, notReady.reason, notReady.message)
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, r.Status().Update(ctx, &policy)
+	} else if err != nil {
+		return ctrl.Result{}, r.reportError(ctx, &policy, err)
+	}
+
+	changes, err := r.syncPolicy(ctx, api, &policy)
+	if err != nil {
+		return ctrl.Result{}, r.reportError(ctx, &policy, err)
+	}
+	if changes > 0 {
+		logf.FromContext(ctx).Info("Synced policy", "id", policy.Status.ID, "changes", changes)
+		r.Recorder.Eventf(&policy, corev1.EventTypeNormal, "Synced", "Applied %d changes to policy %s", changes, policy.Status.ID)
+	}
+
+	policy.Status.ObservedGeneration = policy.Generation
+	setSyncedCondition(&policy.Status.Conditions, policy.Generation, metav1.ConditionTrue, "Synced", "Policy matches the resource")
+	return ctrl.Result{RequeueAfter: defaultSyncInterval}, r.Status().Update(ctx, &policy)
+}
+
+// syncPolicy creates or updates the policy and its permissions, returning the number of changes
+func (r *DirectusPolicyReconciler) syncPolicy(ctx context.Context, api *directusapi.Client, policy *directusv1.DirectusPolicy) (int, error) {
+	spec := policy.Spec
+	desired := directusapi.Policy{
+		Name:        spec.Name,
+		Icon:        spec.Icon,
+		Description: spec.Description,
+		IPAccess:    spec.IPAccess,
+		EnforceTFA:  spec.EnforceTFA,
+		AdminAccess: spec.AdminAccess,
+		AppAccess:   spec.AppAccess,
+	}
+	if desired.Name == "" {
+		desired.Name = policy.Name
+	}
+
+	current, err := findItem[directusapi.Policy](ctx, api, "/policies", policy.Status.ID, "name", desired.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	changes := 0
+	if current == nil {
+		created, err := directusapi.CreateItem(ctx, api, "/policies", &desired)
+		if err != nil {
+			return 0, err
+		}
+		// Record the policy right away, a retry would otherwise find it by
+		// name and take it for a policy the operator did not create
+		policy.Status.ID = created.ID
+		policy.Status.Created = true
+		if err := r.Status().Update(ctx, policy); err != nil {
+			return 0, err
+		}
+		changes++
+	} else {
+		if err := checkAdoption("policy", desired.Name, current.ID, policy.Status.ID, policy.Spec.Adopt); err != nil {
+			return 0, err
+		}
+		if current.ID != policy.Status.ID {
+			policy.Status.Created = false
+		}
+		policy.Status.ID = current.ID
+		desired.ID = current.ID
+		if !policyEqual(current, &desired) {
+			if _, err := directusapi.UpdateItem[directusapi.Policy](ctx, api, "/policies", current.ID, &desired); err != nil {
+				return 0, err
+			}
+			changes++
+		}
+	}
+
+	permissionChanges, err := r.syncPermissions(ctx, api, policy)
+	return changes + permissionChanges, err
+}
+
+// syncPermissions makes the permissions of the policy match the spec, returning the number of changes
+func (r *DirectusPolicyReconciler) syncPermissions(ctx context.Context, api *directusapi.Client, policy *directusv1.DirectusPolicy) (int, error) {
+	current, err := directusapi.ListItems[directusapi.Permission](ctx, api, "/permissions", directusapi.Filter("policy", policy.Status.ID))
+	if err != nil {
+		return 0, err
+	}
+	existing := map[string]directusapi.Permission{}
+	for _, permission := range current {
+		existing[permission.Collection+"/"+permission.Action] = permission
+	}
+
+	changes := 0
+	for _, spec := range policy.Spec.Permissions {
+		desired := directusapi.Permission{
+			Policy:      policy.Status.ID,
+			Collection:  spec.Collection,
+			Action:      spec.Action,
+			Fields:      spec.Fields,
+			Permissions: rawJSON(spec.Permissions),
+			Validation:  rawJSON(spec.Validation),
+			Presets:     rawJSON(spec.Presets),
+		}
+		if len(desired.Fields) == 0 {
+			desired.Fields = []string{"*"}
+		}
+
+		key := spec.Collection + "/" + spec.Action
+		found, ok := existing[key]
+		delete(existing, key)
+		if !ok {
+			if _, err := directusapi.CreateItem(ctx, api, "/permissions", &desired); err != nil {
+				return changes, fmt.Errorf("failed to create permission %s: %w", key, err)
+			}
+			changes++
+			continue
+		}
+		if !permissionEqual(&found, &desired) {
+			if _, err := directusapi.UpdateItem[directusapi.Permission](ctx, api, "/permissions", strconv.Itoa(found.ID), &desired); err != nil {
+				return changes, fmt.Errorf("failed to update permission %s: %w", key, err)
+			}
+			changes++
+		}
+	}
+
+	// Permissions of adopted policies that the resource does not list are kept
+	if !policy.Status.Created {
+		return changes, nil
+	}
+	for key, permission := range existing {
+		if err := directusapi.DeleteItem(ctx, api, "/permissions", strconv.Itoa(permission.ID)); err != nil {
+			return changes, fmt.Errorf("failed to delete permission %s: %w", key, err)
+		}
+		changes++
+	}
+	return changes, nil
+}
+
+// reportError marks the policy as not synced and returns err for a retry
+func (r *DirectusPolicyReconciler) reportError(ctx context.Context, policy *directusv1.DirectusPolicy, err error) error {
+	r.Recorder.Eventf(policy, corev1.EventTypeWarning, "SyncFailed", "%v", err)
+	setSyncedCondition(&policy.Status.Conditions, policy.Generation, metav1.ConditionFalse, syncErrorReason(err), err.Error())
+	if statusErr := r.Status().Update(ctx, policy); statusErr != nil {
+		logf.FromContext(ctx).Error(statusErr, "Failed to update DirectusPolicy status")
+	}
+	return err
+}
+
+// policyEqual compares the managed fields, Directus sets a default icon when none is given
+func policyEqual(a, b *directusapi.Policy) bool {
+	return a.Name == b.Name && (b.Icon == "" || a.Icon == b.Icon) && a.Description == b.Description &&
+		a.EnforceTFA == b.EnforceTFA && a.AdminAccess == b.AdminAccess && a.AppAccess == b.AppAccess &&
+		slices.Equal(a.IPAccess, b.IPAccess)
+}
+
+func permissionEqual(a, b *directusapi.Permission) bool {
+	return slices.Equal(a.Fields, b.Fields) &&
+		jsonEqual(a.Permissions, b.Permissions) &&
+		jsonEqual(a.Validation, b.Validation) &&
+		jsonEqual(a.Presets, b.Presets)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DirectusPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&directusv1.DirectusPolicy{}).
+		Named("directuspolicy").
+		Complete(r)
+}
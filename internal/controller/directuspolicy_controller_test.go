@@ -0,0 +1,142 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+var _ = Describe("DirectusPolicy Controller", func() {
+	Context("When reconciling a resource", func() {
+		const directusName = "policy-directus"
+		const resourceName = "editors"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createRunningDirectus(ctx, directusName)
+
+			policy := &directusv1.DirectusPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusPolicySpec{
+					DirectusRef: corev1.LocalObjectReference{Name: directusName},
+					AppAccess:   true,
+					Permissions: []directusv1.DirectusPermission{
+						{
+							Collection:  "articles",
+							Action:      "read",
+							Permissions: &runtime.RawExtension{Raw: []byte(`{"status":{"_eq":"published"}}`)},
+						},
+						{Collection: "articles", Action: "update", Fields: []string{"title", "body"}},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			deleteDirectus(ctx, directusName)
+		})
+
+		It("should create the policy and its permissions and delete them with the resource", func() {
+			var permissions []map[string]any
+			deleted := []string{}
+			server, mux := newFakeDirectus()
+			defer server.Close()
+			mux.HandleFunc("GET /policies", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":[]}`))
+			})
+			mux.HandleFunc("POST /policies", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":{"id":"policy-1","name":"editors","app_access":true}}`))
+			})
+			mux.HandleFunc("GET /permissions", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("filter[policy][_eq]")).To(Equal("policy-1"))
+				_, _ = w.Write([]byte(`{"data":[{"id":3,"policy":"policy-1","collection":"comments","action":"delete"}]}`))
+			})
+			mux.HandleFunc("POST /permissions", func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]any
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				permissions = append(permissions, body)
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			})
+			mux.HandleFunc("DELETE /permissions/{id}", func(w http.ResponseWriter, r *http.Request) {
+				deleted = append(deleted, "permission/"+r.PathValue("id"))
+				w.WriteHeader(http.StatusNoContent)
+			})
+			mux.HandleFunc("DELETE /policies/{id}", func(w http.ResponseWriter, r *http.Request) {
+				deleted = append(deleted, "policy/"+r.PathValue("id"))
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			controllerReconciler := &DirectusPolicyReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			policy := &directusv1.DirectusPolicy{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, policy)).To(Succeed())
+			Expect(policy.Status.ID).To(Equal("policy-1"))
+			Expect(policy.Status.Created).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(policy.Status.Conditions, directusv1.ConditionSynced)).To(BeTrue())
+
+			Expect(permissions).To(HaveLen(2))
+			Expect(permissions[0]).To(HaveKeyWithValue("fields", ConsistOf("*")))
+			Expect(permissions[0]).To(HaveKeyWithValue("permissions", HaveKey("status")))
+			Expect(permissions[1]).To(HaveKeyWithValue("fields", ConsistOf("title", "body")))
+			Expect(deleted).To(ConsistOf("permission/3"))
+
+			By("deleting the resource")
+			Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(deleted).To(ContainElement("policy/policy-1"))
+			err = k8sClient.Get(ctx, typeNamespacedName, policy)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,357 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// restoreFinalizer scales the instance back up when a running restore is deleted
+const restoreFinalizer = "directus.example.com/restore"
+
+// DirectusRestoreReconciler reconciles a DirectusRestore object
+type DirectusRestoreReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusrestores,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusrestores/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusrestores/finalizers,verbs=update
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusbackups,verbs=get;list;watch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directuses,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+
+// Reconcile moves a DirectusRestore through its phases: the instance is scaled
+// to zero by annotating it, the dump is restored and the migrations are run by
+// Jobs, then the annotation is removed and the instance scales back up.
+func (r *DirectusRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var restore directusv1.DirectusRestore
+	if err := r.Get(ctx, req.NamespacedName, &restore); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !restore.DeletionTimestamp.IsZero() {
+		if err := r.releaseDirectus(ctx, &restore); err != nil {
+			return ctrl.Result{}, err
+		}
+		controllerutil.RemoveFinalizer(&restore, restoreFinalizer)
+		return ctrl.Result{}, r.Update(ctx, &restore)
+	}
+
+	if restore.Status.Phase == directusv1.PhaseSucceeded || restore.Status.Phase == directusv1.PhaseFailed {
+		return ctrl.Result{}, nil
+	}
+
+	if controllerutil.AddFinalizer(&restore, restoreFinalizer) {
+		if err := r.Update(ctx, &restore); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	var directus directusv1.Directus
+	directusKey := types.NamespacedName{Name: restore.Spec.DirectusRef.Name, Namespace: restore.Namespace}
+	if err := r.Get(ctx, directusKey, &directus); err != nil {
+		if errors.IsNotFound(err) {
+			return r.setPhase(ctx, &restore, directusv1.PhasePending,
+				fmt.Sprintf("Directus %s not found", directusKey.Name), 30*time.Second)
+		}
+		return ctrl.Result{}, err
+	}
+	applyDefaults(&directus)
+
+	switch restore.Status.Phase {
+	case "", directusv1.PhasePending:
+		return r.scaleDown(ctx, &restore, &directus)
+	case directusv1.PhaseScalingDown:
+		return r.startRestore(ctx, &restore, &directus)
+	case directusv1.PhaseRestoring:
+		return r.startMigration(ctx, &restore, &directus)
+	case directusv1.PhaseMigrating:
+		return r.scaleUp(ctx, &restore)
+	case directusv1.PhaseScalingUp:
+		return r.waitForInstance(ctx, &restore, &directus)
+	}
+	return ctrl.Result{}, nil
+}
+
+// scaleDown annotates the instance so that its Deployment is scaled to zero
+func (r *DirectusRestoreReconciler) scaleDown(ctx context.Context, restore *directusv1.DirectusRestore, directus *directusv1.Directus) (ctrl.Result, error) {
+	opts, waiting, err := r.restoreOptions(ctx, restore)
+	if err != nil {
+		return r.fail(ctx, restore, err.Error())
+	}
+	if waiting != "" {
+		return r.setPhase(ctx, restore, directusv1.PhasePending, waiting, 30*time.Second)
+	}
+
+	if owner, ok := directus.Annotations[directusv1.RestoreInProgressAnnotation]; ok && owner != restore.Name {
+		return r.setPhase(ctx, restore, directusv1.PhasePending,
+			fmt.Sprintf("Waiting for DirectusRestore %s to finish", owner), 30*time.Second)
+	}
+
+	if directus.Annotations[directusv1.RestoreInProgressAnnotation] != restore.Name {
+		patch := client.MergeFrom(directus.DeepCopy())
+		metav1.SetMetaDataAnnotation(&directus.ObjectMeta, directusv1.RestoreInProgressAnnotation, restore.Name)
+		if err := r.Patch(ctx, directus, patch); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Eventf(restore, corev1.EventTypeNormal, "ScalingDown", "Scaling Directus %s to zero", directus.Name)
+	}
+
+	restore.Status.Location = opts.Location
+	restore.Status.StartTime = ptr.To(metav1.Now())
+	return r.setPhase(ctx, restore, directusv1.PhaseScalingDown, "Waiting for Directus pods to terminate", 5*time.Second)
+}
+
+// startRestore runs the restore Job once all Directus pods are gone
+func (r *DirectusRestoreReconciler) startRestore(ctx context.Context, restore *directusv1.DirectusRestore, directus *directusv1.Directus) (ctrl.Result, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(directus.Namespace), client.MatchingLabels{
+		"app.kubernetes.io/instance":  directus.Name,
+		"app.kubernetes.io/component": "directus",
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(pods.Items) > 0 {
+		return r.setPhase(ctx, restore, directusv1.PhaseScalingDown,
+			fmt.Sprintf("Waiting for %d Directus pods to terminate", len(pods.Items)), 5*time.Second)
+	}
+
+	opts, _, err := r.restoreOptions(ctx, restore)
+	if err == nil && opts.Location != restore.Status.Location {
+		err = fmt.Errorf("backup location changed from %s to %s", restore.Status.Location, opts.Location)
+	}
+	if err != nil {
+		return r.fail(ctx, restore, err.Error())
+	}
+	podSpec, err := buildRestorePodSpec(directus, opts)
+	if err != nil {
+		return r.fail(ctx, restore, err.Error())
+	}
+	template := &corev1.PodTemplateSpec{Spec: *podSpec}
+	if err := r.ensureJob(ctx, restore, directus, restore.Name+"-restore", template); err != nil {
+		return ctrl.Result{}, err
+	}
+	return r.setPhase(ctx, restore, directusv1.PhaseRestoring, "Restoring "+restore.Status.Location, 0)
+}
+
+// startMigration runs the migrations Job once the dump is restored
+func (r *DirectusRestoreReconciler) startMigration(ctx context.Context, restore *directusv1.DirectusRestore, directus *directusv1.Directus) (ctrl.Result, error) {
+	job, done, err := r.jobResult(ctx, restore, restore.Name+"-restore")
+	if err != nil || !done {
+		return ctrl.Result{}, err
+	}
+	if !jobSucceeded(job) {
+		return r.fail(ctx, restore, fmt.Sprintf("Restore Job %s failed", job.Name))
+	}
+
+	template, err := buildMigrationPodTemplate(directus)
+	if err != nil {
+		return r.fail(ctx, restore, err.Error())
+	}
+	if err := r.ensureJob(ctx, restore, directus, restore.Name+"-migrate", template); err != nil {
+		return ctrl.Result{}, err
+	}
+	return r.setPhase(ctx, restore, directusv1.PhaseMigrating, "Running database migrations", 0)
+}
+
+// scaleUp releases the instance once the migrations completed
+func (r *DirectusRestoreReconciler) scaleUp(ctx context.Context, restore *directusv1.DirectusRestore) (ctrl.Result, error) {
+	job, done, err := r.jobResult(ctx, restore, restore.Name+"-migrate")
+	if err != nil || !done {
+		return ctrl.Result{}, err
+	}
+	if !jobSucceeded(job) {
+		return r.fail(ctx, restore, fmt.Sprintf("Migration Job %s failed", job.Name))
+	}
+
+	if err := r.releaseDirectus(ctx, restore); err != nil {
+		return ctrl.Result{}, err
+	}
+	return r.setPhase(ctx, restore, directusv1.PhaseScalingUp, "Waiting for Directus to become ready", 10*time.Second)
+}
+
+// waitForInstance completes the restore once a Directus replica is ready
+func (r *DirectusRestoreReconciler) waitForInstance(ctx context.Context, restore *directusv1.DirectusRestore, directus *directusv1.Directus) (ctrl.Result, error) {
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: directus.Name, Namespace: directus.Namespace}, deployment); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if deployment.Status.ReadyReplicas == 0 {
+		return r.setPhase(ctx, restore, directusv1.PhaseScalingUp, "Waiting for Directus to become ready", 10*time.Second)
+	}
+
+	logf.FromContext(ctx).Info("Restore completed", "location", restore.Status.Location)
+	r.Recorder.Eventf(restore, corev1.EventTypeNormal, "RestoreSucceeded", "Restored %s", restore.Status.Location)
+	return r.finish(ctx, restore, directusv1.PhaseSucceeded, "Restore completed")
+}
+
+// restoreOptions resolves the dump to restore; a non-empty message means the
+// referenced backup is not available yet
+func (r *DirectusRestoreReconciler) restoreOptions(ctx context.Context, restore *directusv1.DirectusRestore) (restoreOptions, string, error) {
+	opts := restoreOptions{
+		Location:         restore.Spec.Location,
+		Storage:          restore.Spec.Storage,
+		Image:            restore.Spec.Image,
+		UploadsClaimName: restore.Spec.UploadsClaimName,
+		Resources:        restore.Spec.Resources,
+	}
+
+	if ref := restore.Spec.BackupRef; ref != nil {
+		backup := &directusv1.DirectusBackup{}
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: restore.Namespace}, backup); err != nil {
+			if errors.IsNotFound(err) {
+				return opts, fmt.Sprintf("DirectusBackup %s not found", ref.Name), nil
+			}
+			return opts, "", err
+		}
+		switch backup.Status.Phase {
+		case directusv1.PhaseSucceeded:
+		case directusv1.PhaseFailed:
+			return opts, "", fmt.Errorf("DirectusBackup %s failed", ref.Name)
+		default:
+			return opts, fmt.Sprintf("Waiting for DirectusBackup %s to succeed", ref.Name), nil
+		}
+		opts.Location = backup.Status.Location
+		opts.Storage = backup.Spec.Storage
+	}
+
+	if opts.Location == "" {
+		return opts, "", fmt.Errorf("either backupRef or location must be set")
+	}
+	if _, _, _, err := parseBackupLocation(opts.Location); err != nil {
+		return opts, "", err
+	}
+	return opts, "", nil
+}
+
+// ensureJob creates a Job owned by the restore unless it already exists
+func (r *DirectusRestoreReconciler) ensureJob(ctx context.Context, restore *directusv1.DirectusRestore, directus *directusv1.Directus, name string, template *corev1.PodTemplateSpec) error {
+	labels := backupLabels(directus)
+	labels["app.kubernetes.io/component"] = "restore"
+	template.Labels = mergeMetadata(template.Labels, labels, nil)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: restore.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](0),
+			Template:     *template,
+		},
+	}
+	if err := controllerutil.SetControllerReference(restore, job, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, job); err != nil {
+		if errors.IsAlreadyExists(err) {
+			return nil
+		}
+		return err
+	}
+	r.Recorder.Eventf(restore, corev1.EventTypeNormal, ReasonCreated, "Created Job %s", name)
+	return nil
+}
+
+// jobResult returns the named Job and whether it finished
+func (r *DirectusRestoreReconciler) jobResult(ctx context.Context, restore *directusv1.DirectusRestore, name string) (*batchv1.Job, bool, error) {
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: restore.Namespace}, job); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, false, fmt.Errorf("job %s not found", name)
+		}
+		return nil, false, err
+	}
+	return job, jobSucceeded(job) || jobFailed(job), nil
+}
+
+// releaseDirectus removes the restore annotation so that the instance scales back up
+func (r *DirectusRestoreReconciler) releaseDirectus(ctx context.Context, restore *directusv1.DirectusRestore) error {
+	directus := &directusv1.Directus{}
+	if err := r.Get(ctx, types.NamespacedName{Name: restore.Spec.DirectusRef.Name, Namespace: restore.Namespace}, directus); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if directus.Annotations[directusv1.RestoreInProgressAnnotation] != restore.Name {
+		return nil
+	}
+	patch := client.MergeFrom(directus.DeepCopy())
+	delete(directus.Annotations, directusv1.RestoreInProgressAnnotation)
+	return r.Patch(ctx, directus, patch)
+}
+
+// fail releases the instance and marks the restore as failed
+func (r *DirectusRestoreReconciler) fail(ctx context.Context, restore *directusv1.DirectusRestore, message string) (ctrl.Result, error) {
+	if err := r.releaseDirectus(ctx, restore); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.Recorder.Eventf(restore, corev1.EventTypeWarning, "RestoreFailed", "%s", message)
+	return r.finish(ctx, restore, directusv1.PhaseFailed, message)
+}
+
+// finish records the final phase and drops the finalizer
+func (r *DirectusRestoreReconciler) finish(ctx context.Context, restore *directusv1.DirectusRestore, phase, message string) (ctrl.Result, error) {
+	restore.Status.CompletionTime = ptr.To(metav1.Now())
+	if _, err := r.setPhase(ctx, restore, phase, message, 0); err != nil {
+		return ctrl.Result{}, err
+	}
+	if controllerutil.RemoveFinalizer(restore, restoreFinalizer) {
+		return ctrl.Result{}, r.Update(ctx, restore)
+	}
+	return ctrl.Result{}, nil
+}
+
+// setPhase updates the phase and message of the restore status
+func (r *DirectusRestoreReconciler) setPhase(ctx context.Context, restore *directusv1.DirectusRestore, phase, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	restore.Status.Phase = phase
+	restore.Status.Message = message
+	if err := r.Status().Update(ctx, restore); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DirectusRestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&directusv1.DirectusRestore{}).
+		Owns(&batchv1.Job{}).
+		Named("directusrestore").
+		Complete(r)
+}
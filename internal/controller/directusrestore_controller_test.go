@@ -0,0 +1,197 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+var _ = Describe("DirectusRestore Controller", func() {
+	Context("When reconciling a resource", func() {
+		const directusName = "restore-directus"
+		const resourceName = "restore"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		directusNamespacedName := types.NamespacedName{
+			Name:      directusName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			directus := &directusv1.Directus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      directusName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSpec{
+					ReplicaCount: 2,
+					Database: directusv1.DirectusDatabase{
+						Engine:   "postgresql",
+						Host:     "postgresql",
+						Database: "directus",
+						Username: "directus",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, directus)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, directusNamespacedName, directus)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, directus)).To(Succeed())
+		})
+
+		It("should scale down the instance and run the restore Job", func() {
+			restore := &directusv1.DirectusRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusRestoreSpec{
+					DirectusRef: corev1.LocalObjectReference{Name: directusName},
+					Location:    "pvc://backups/" + directusName + "/snapshots/snapshot-20250101000000.sql.gz",
+				},
+			}
+			Expect(k8sClient.Create(ctx, restore)).To(Succeed())
+
+			restoreReconciler := &DirectusRestoreReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+			directusReconciler := &DirectusReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := restoreReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, restore)).To(Succeed())
+			Expect(restore.Status.Phase).To(Equal(directusv1.PhaseScalingDown))
+
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, directusNamespacedName, directus)).To(Succeed())
+			Expect(directus.Annotations).To(HaveKeyWithValue(directusv1.RestoreInProgressAnnotation, resourceName))
+
+			By("reconciling the Directus instance")
+			_, err = directusReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: directusNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, directusNamespacedName, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(BeZero())
+
+			By("starting the restore once no pods are left")
+			_, err = restoreReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, restore)).To(Succeed())
+			Expect(restore.Status.Phase).To(Equal(directusv1.PhaseRestoring))
+
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-restore", Namespace: "default"}, job)).To(Succeed())
+			Expect(job.Spec.Template.Spec.InitContainers[0].Env).To(ContainElement(corev1.EnvVar{
+				Name: "DUMP_PATH", Value: directusName + "/snapshots/snapshot-20250101000000.sql.gz",
+			}))
+			Expect(job.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("VolumeSource.PersistentVolumeClaim.ClaimName", "backups")))
+
+			By("deleting the restore before it completes")
+			Expect(k8sClient.Delete(ctx, restore)).To(Succeed())
+			_, err = restoreReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, directusNamespacedName, directus)).To(Succeed())
+			Expect(directus.Annotations).NotTo(HaveKey(directusv1.RestoreInProgressAnnotation))
+			err = k8sClient.Get(ctx, typeNamespacedName, restore)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should run the migrations with the pod configuration of the instance", func() {
+			directus := &directusv1.Directus{}
+			Expect(k8sClient.Get(ctx, directusNamespacedName, directus)).To(Succeed())
+			directus.Spec.SecretsFrom = &directusv1.DirectusSecretsFrom{
+				Vault: &directusv1.DirectusVaultSecrets{Role: "directus", Path: "secret/data/directus"},
+			}
+			directus.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}}
+			directus.Spec.Sidecars = []corev1.Container{{Name: "proxy", Image: "envoy"}}
+			directus.Spec.PodTemplate = &runtime.RawExtension{Raw: []byte(`{"spec":{"priorityClassName":"critical"}}`)}
+
+			template, err := buildMigrationPodTemplate(directus)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(template.Annotations).To(HaveKeyWithValue("vault.hashicorp.com/agent-inject", "true"))
+			Expect(template.Annotations).To(HaveKeyWithValue("vault.hashicorp.com/agent-pre-populate-only", "true"))
+			Expect(template.Annotations).To(HaveKeyWithValue("vault.hashicorp.com/agent-inject-secret-KEY", "secret/data/directus"))
+			Expect(template.Labels).NotTo(HaveKey("app.kubernetes.io/component"))
+			Expect(template.Spec.ServiceAccountName).To(Equal(directusName + "-sa"))
+			Expect(template.Spec.Tolerations).To(HaveLen(1))
+			Expect(template.Spec.PriorityClassName).To(Equal("critical"))
+			Expect(template.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+
+			Expect(template.Spec.Containers).To(HaveLen(1))
+			migrate := template.Spec.Containers[0]
+			Expect(migrate.Name).To(Equal("migrate"))
+			Expect(migrate.Command).To(Equal([]string{"node", "cli.js", "database", "migrate:latest"}))
+			Expect(migrate.Env).To(ContainElement(corev1.EnvVar{Name: "KEY_FILE", Value: "/vault/secrets/KEY"}))
+			Expect(migrate.ReadinessProbe).To(BeNil())
+		})
+
+		It("should fail on an invalid location", func() {
+			restore := &directusv1.DirectusRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusRestoreSpec{
+					DirectusRef: corev1.LocalObjectReference{Name: directusName},
+					Location:    "ftp://backups/dump.sql.gz",
+				},
+			}
+			Expect(k8sClient.Create(ctx, restore)).To(Succeed())
+
+			restoreReconciler := &DirectusRestoreReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+			_, err := restoreReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, restore)).To(Succeed())
+			Expect(restore.Status.Phase).To(Equal(directusv1.PhaseFailed))
+			Expect(restore.Finalizers).To(BeEmpty())
+			Expect(k8sClient.Delete(ctx, restore)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,216 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+	"github.com/example/directus-operator/internal/directusapi"
+)
+
+// DirectusRoleReconciler reconciles a DirectusRole object
+type DirectusRoleReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	API      DirectusAPI
+}
+
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusroles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusroles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusroles/finalizers,verbs=update
+// +kubebuilder:rbac:groups=directus.example.com,resources=directuspolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directuses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// Reconcile creates or updates the role in the referenced instance and
+// attaches the referenced policies, and deletes the role when the resource is
+// deleted.
+func (r *DirectusRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var role directusv1.DirectusRole
+	if err := r.Get(ctx, req.NamespacedName, &role); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !role.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&role, directusObjectFinalizer) {
+			return ctrl.Result{}, nil
+		}
+		// Adopted roles existed before the resource and are kept
+		if role.Status.Created {
+			if err := r.API.deleteDirectusObject(ctx, r.Client, role.Namespace, role.Spec.DirectusRef, "/roles", role.Status.ID); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		controllerutil.RemoveFinalizer(&role, directusObjectFinalizer)
+		return ctrl.Result{}, r.Update(ctx, &role)
+	}
+
+	if controllerutil.AddFinalizer(&role, directusObjectFinalizer) {
+		if err := r.Update(ctx, &role); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	_, api, err := r.API.connectInstance(ctx, r.Client, role.Namespace, role.Spec.DirectusRef)
+	if err == nil {
+		var policyIDs []string
+		if policyIDs, err = r.policyIDs(ctx, &role); err == nil {
+			var changes int
+			if changes, err = r.syncRole(ctx, api, &role, policyIDs); err == nil && changes > 0 {
+				logf.FromContext(ctx).Info("Synced role", "id", role.Status.ID, "changes", changes)
+				r.Recorder.Eventf(&role, corev1.EventTypeNormal, "Synced", "Applied %d changes to role %s", changes, role.Status.ID)
+			}
+		}
+	}
+	if notReady, ok := err.(*instanceNotReadyError); ok {
+		setSyncedCondition(&role.Status.Conditions, role.Generation, metav1.ConditionUnknown, notReady.reason, notReady.message)
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, r.Status().Update(ctx, &role)
+	} else if err != nil {
+		return ctrl.Result{}, r.reportError(ctx, &role, err)
+	}
+
+	role.Status.ObservedGeneration = role.Generation
+	setSyncedCondition(&role.Status.Conditions, role.Generation, metav1.ConditionTrue, "Synced", "Role matches the resource")
+	return ctrl.Result{RequeueAfter: defaultSyncInterval}, r.Status().Update(ctx, &role)
+}
+
+// policyIDs returns the Directus IDs of the referenced policies
+func (r *DirectusRoleReconciler) policyIDs(ctx context.Context, role *directusv1.DirectusRole) ([]string, error) {
+	var ids []string
+	for _, ref := range role.Spec.PolicyRefs {
+		policy := &directusv1.DirectusPolicy{}
+		err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: role.Namespace}, policy)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if err != nil || policy.Status.ID == "" {
+			return nil, &instanceNotReadyError{"PolicyNotReady", fmt.Sprintf("Waiting for DirectusPolicy %s to be synced", ref.Name)}
+		}
+		ids = append(ids, policy.Status.ID)
+	}
+	return ids, nil
+}
+
+// syncRole creates or updates the role and its policy attachments, returning the number of changes
+func (r *DirectusRoleReconciler) syncRole(ctx context.Context, api *directusapi.Client, role *directusv1.DirectusRole, policyIDs []string) (int, error) {
+	desired := directusapi.Role{
+		Name:        role.Spec.Name,
+		Icon:        role.Spec.Icon,
+		Description: role.Spec.Description,
+	}
+	if desired.Name == "" {
+		desired.Name = role.Name
+	}
+
+	current, err := findItem[directusapi.Role](ctx, api, "/roles", role.Status.ID, "name", desired.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	changes := 0
+	if current == nil {
+		created, err := directusapi.CreateItem(ctx, api, "/roles", &desired)
+		if err != nil {
+			return 0, err
+		}
+		// Record the role right away, a retry would otherwise find it by name
+		// and take it for a role the operator did not create
+		role.Status.ID = created.ID
+		role.Status.Created = true
+		if err := r.Status().Update(ctx, role); err != nil {
+			return 0, err
+		}
+		changes++
+	} else {
+		if err := checkAdoption("role", desired.Name, current.ID, role.Status.ID, role.Spec.Adopt); err != nil {
+			return 0, err
+		}
+		if current.ID != role.Status.ID {
+			role.Status.Created = false
+		}
+		role.Status.ID = current.ID
+		desired.ID = current.ID
+		if !roleEqual(current, &desired) {
+			if _, err := directusapi.UpdateItem[directusapi.Role](ctx, api, "/roles", current.ID, &desired); err != nil {
+				return 0, err
+			}
+			changes++
+		}
+	}
+
+	accessChanges, err := syncAccess(ctx, api, "role", role.Status.ID, policyIDs, role.Status.Created)
+	return changes + accessChanges, err
+}
+
+// reportError marks the role as not synced and returns err for a retry
+func (r *DirectusRoleReconciler) reportError(ctx context.Context, role *directusv1.DirectusRole, err error) error {
+	r.Recorder.Eventf(role, corev1.EventTypeWarning, "SyncFailed", "%v", err)
+	setSyncedCondition(&role.Status.Conditions, role.Generation, metav1.ConditionFalse, syncErrorReason(err), err.Error())
+	if statusErr := r.Status().Update(ctx, role); statusErr != nil {
+		logf.FromContext(ctx).Error(statusErr, "Failed to update DirectusRole status")
+	}
+	return err
+}
+
+// roleEqual compares the managed fields, Directus sets a default icon when none is given
+func roleEqual(a, b *directusapi.Role) bool {
+	return a.Name == b.Name && a.Description == b.Description && (b.Icon == "" || a.Icon == b.Icon)
+}
+
+// rolesForPolicy maps a policy to the roles referencing it
+func (r *DirectusRoleReconciler) rolesForPolicy(ctx context.Context, obj client.Object) []reconcile.Request {
+	roles := &directusv1.DirectusRoleList{}
+	if err := r.List(ctx, roles, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, role := range roles.Items {
+		for _, ref := range role.Spec.PolicyRefs {
+			if ref.Name == obj.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&role)})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DirectusRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&directusv1.DirectusRole{}).
+		Watches(&directusv1.DirectusPolicy{}, handler.EnqueueRequestsFromMapFunc(r.rolesForPolicy)).
+		Named("directusrole").
+		Complete(r)
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+var _ = Describe("DirectusRole Controller", func() {
+	Context("When reconciling a resource", func() {
+		const directusName = "role-directus"
+		const resourceName = "editor"
+		const policyName = "role-editors"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createRunningDirectus(ctx, directusName)
+
+			role := &directusv1.DirectusRole{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusRoleSpec{
+					DirectusRef: corev1.LocalObjectReference{Name: directusName},
+					Name:        "Editor",
+					PolicyRefs:  []corev1.LocalObjectReference{{Name: policyName}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, role)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			role := &directusv1.DirectusRole{}
+			if err := k8sClient.Get(ctx, typeNamespacedName, role); err == nil {
+				role.Finalizers = nil
+				Expect(k8sClient.Update(ctx, role)).To(Succeed())
+				Expect(k8sClient.Delete(ctx, role)).To(Succeed())
+			}
+
+			policy := &directusv1.DirectusPolicy{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Name: policyName, Namespace: "default"}, policy); err == nil {
+				Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+			}
+			deleteDirectus(ctx, directusName)
+		})
+
+		It("should wait for its policies and then attach them", func() {
+			var access []map[string]any
+			created := false
+			server, mux := newFakeDirectus()
+			defer server.Close()
+			mux.HandleFunc("GET /roles", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("filter[name][_eq]")).To(Equal("Editor"))
+				_, _ = w.Write([]byte(`{"data":[]}`))
+			})
+			mux.HandleFunc("POST /roles", func(w http.ResponseWriter, r *http.Request) {
+				created = true
+				_, _ = w.Write([]byte(`{"data":{"id":"role-1","name":"Editor","icon":"supervised_user_circle"}}`))
+			})
+			mux.HandleFunc("GET /roles/{id}", func(w http.ResponseWriter, r *http.Request) {
+				if !created {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(`{"data":{"id":"role-1","name":"Editor","icon":"supervised_user_circle"}}`))
+			})
+			mux.HandleFunc("GET /access", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":[]}`))
+			})
+			mux.HandleFunc("POST /access", func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]any
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				access = append(access, body)
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			})
+
+			controllerReconciler := &DirectusRoleReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			role := &directusv1.DirectusRole{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, role)).To(Succeed())
+			synced := meta.FindStatusCondition(role.Status.Conditions, directusv1.ConditionSynced)
+			Expect(synced).NotTo(BeNil())
+			Expect(synced.Reason).To(Equal("PolicyNotReady"))
+
+			By("syncing the policy")
+			policy := &directusv1.DirectusPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      policyName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusPolicySpec{
+					DirectusRef: corev1.LocalObjectReference{Name: directusName},
+				},
+			}
+			Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+			policy.Status.ID = "policy-1"
+			Expect(k8sClient.Status().Update(ctx, policy)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, role)).To(Succeed())
+			Expect(role.Status.ID).To(Equal("role-1"))
+			Expect(role.Status.Created).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(role.Status.Conditions, directusv1.ConditionSynced)).To(BeTrue())
+			Expect(access).To(ConsistOf(And(HaveKeyWithValue("role", "role-1"), HaveKeyWithValue("policy", "policy-1"))))
+		})
+
+		It("should only adopt an existing role when asked and never delete it", func() {
+			var access []map[string]any
+			deleted := []string{}
+			server, mux := newFakeDirectus()
+			defer server.Close()
+			mux.HandleFunc("GET /roles", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":[{"id":"role-9","name":"Editor","icon":"supervised_user_circle"}]}`))
+			})
+			mux.HandleFunc("GET /roles/{id}", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":{"id":"role-9","name":"Editor","icon":"supervised_user_circle"}}`))
+			})
+			mux.HandleFunc("GET /access", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":[{"id":"access-1","role":"role-9","policy":"policy-other"}]}`))
+			})
+			mux.HandleFunc("POST /access", func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]any
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				access = append(access, body)
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			})
+			mux.HandleFunc("DELETE /access/{id}", func(w http.ResponseWriter, r *http.Request) {
+				deleted = append(deleted, "access/"+r.PathValue("id"))
+				w.WriteHeader(http.StatusNoContent)
+			})
+			mux.HandleFunc("DELETE /roles/{id}", func(w http.ResponseWriter, r *http.Request) {
+				deleted = append(deleted, "role/"+r.PathValue("id"))
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			policy := &directusv1.DirectusPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      policyName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusPolicySpec{
+					DirectusRef: corev1.LocalObjectReference{Name: directusName},
+				},
+			}
+			Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+			policy.Status.ID = "policy-1"
+			Expect(k8sClient.Status().Update(ctx, policy)).To(Succeed())
+
+			controllerReconciler := &DirectusRoleReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(HaveOccurred())
+
+			role := &directusv1.DirectusRole{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, role)).To(Succeed())
+			Expect(role.Status.ID).To(BeEmpty())
+			synced := meta.FindStatusCondition(role.Status.Conditions, directusv1.ConditionSynced)
+			Expect(synced).NotTo(BeNil())
+			Expect(synced.Reason).To(Equal("AlreadyExists"))
+
+			By("opting in to adoption")
+			role.Spec.Adopt = true
+			Expect(k8sClient.Update(ctx, role)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, role)).To(Succeed())
+			Expect(role.Status.ID).To(Equal("role-9"))
+			Expect(role.Status.Created).To(BeFalse())
+			Expect(access).To(ConsistOf(HaveKeyWithValue("policy", "policy-1")))
+			Expect(deleted).To(BeEmpty())
+
+			By("deleting the resource")
+			Expect(k8sClient.Delete(ctx, role)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,201 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// defaultSchemaInterval is how often a DirectusSchema is checked for drift
+const defaultSchemaInterval = 5 * time.Minute
+
+// DirectusSchemaReconciler reconciles a DirectusSchema object
+type DirectusSchemaReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	API      DirectusAPI
+}
+
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusschemas,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusschemas/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusschemas/finalizers,verbs=update
+// +kubebuilder:rbac:groups=directus.example.com,resources=directuses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// Reconcile compares the snapshot with the schema of the instance through
+// /schema/diff and applies the differences unless the schema is a dry run.
+// The comparison is repeated periodically to report drift.
+func (r *DirectusSchemaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	var schema directusv1.DirectusSchema
+	if err := r.Get(ctx, req.NamespacedName, &schema); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	interval := defaultSchemaInterval
+	if schema.Spec.Interval != nil && schema.Spec.Interval.Duration > 0 {
+		interval = schema.Spec.Interval.Duration
+	}
+
+	snapshot, err := r.loadSnapshot(ctx, &schema)
+	if err != nil {
+		r.setSynced(&schema, metav1.ConditionFalse, "InvalidSnapshot", err.Error())
+		return ctrl.Result{RequeueAfter: interval}, r.Status().Update(ctx, &schema)
+	}
+
+	_, api, err := r.API.connectInstance(ctx, r.Client, schema.Namespace, schema.Spec.DirectusRef)
+	if notReady, ok := err.(*instanceNotReadyError); ok {
+		r.setSynced(&schema, metav1.ConditionUnknown, notReady.reason, notReady.message)
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, r.Status().Update(ctx, &schema)
+	} else if err != nil {
+		return ctrl.Result{}, r.reportError(ctx, &schema, err)
+	}
+
+	diff, err := api.SchemaDiff(ctx, snapshot, schema.Spec.Force)
+	if err != nil {
+		return ctrl.Result{}, r.reportError(ctx, &schema, err)
+	}
+
+	schema.Status.LastCheckTime = ptr.To(metav1.Now())
+	schema.Status.ObservedGeneration = schema.Generation
+
+	if diff == nil {
+		schema.Status.InSync = true
+		schema.Status.Changes = nil
+		r.setSynced(&schema, metav1.ConditionTrue, "InSync", "Schema matches the snapshot")
+		return ctrl.Result{RequeueAfter: interval}, r.Status().Update(ctx, &schema)
+	}
+
+	changes, err := diff.Changes()
+	if err != nil {
+		return ctrl.Result{}, r.reportError(ctx, &schema, err)
+	}
+
+	if schema.Spec.DryRun {
+		if schema.Status.InSync || !meta.IsStatusConditionPresentAndEqual(schema.Status.Conditions, directusv1.ConditionSynced, metav1.ConditionFalse) {
+			r.Recorder.Eventf(&schema, corev1.EventTypeWarning, "DriftDetected", "%d schema differences found", len(changes))
+		}
+		schema.Status.InSync = false
+		schema.Status.Changes = changes
+		r.setSynced(&schema, metav1.ConditionFalse, "DriftDetected",
+			fmt.Sprintf("%d differences, not applied in dry run", len(changes)))
+		return ctrl.Result{RequeueAfter: interval}, r.Status().Update(ctx, &schema)
+	}
+
+	if err := api.SchemaApply(ctx, diff); err != nil {
+		return ctrl.Result{}, r.reportError(ctx, &schema, err)
+	}
+	log.Info("Applied schema differences", "changes", changes)
+	r.Recorder.Eventf(&schema, corev1.EventTypeNormal, "SchemaApplied", "Applied %d schema differences: %s",
+		len(changes), strings.Join(changes, ", "))
+
+	schema.Status.InSync = true
+	schema.Status.Changes = nil
+	schema.Status.LastAppliedTime = ptr.To(metav1.Now())
+	r.setSynced(&schema, metav1.ConditionTrue, "Applied", fmt.Sprintf("Applied %d differences", len(changes)))
+	return ctrl.Result{RequeueAfter: interval}, r.Status().Update(ctx, &schema)
+}
+
+// loadSnapshot returns the snapshot as JSON, from the spec or the ConfigMap
+func (r *DirectusSchemaReconciler) loadSnapshot(ctx context.Context, schema *directusv1.DirectusSchema) (json.RawMessage, error) {
+	if schema.Spec.Snapshot != nil && len(schema.Spec.Snapshot.Raw) > 0 {
+		return schema.Spec.Snapshot.Raw, nil
+	}
+
+	ref := schema.Spec.SnapshotFrom
+	if ref == nil {
+		return nil, fmt.Errorf("either snapshot or snapshotFrom must be set")
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: schema.Namespace}, configMap); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("ConfigMap %s not found", ref.Name)
+		}
+		return nil, err
+	}
+	data, ok := configMap.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in ConfigMap %s", ref.Key, ref.Name)
+	}
+	snapshot, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot in ConfigMap %s: %w", ref.Name, err)
+	}
+	return snapshot, nil
+}
+
+// reportError marks the schema as not synced and returns err for a retry
+func (r *DirectusSchemaReconciler) reportError(ctx context.Context, schema *directusv1.DirectusSchema, err error) error {
+	r.Recorder.Eventf(schema, corev1.EventTypeWarning, "SyncFailed", "%v", err)
+	r.setSynced(schema, metav1.ConditionFalse, "APIError", err.Error())
+	if statusErr := r.Status().Update(ctx, schema); statusErr != nil {
+		logf.FromContext(ctx).Error(statusErr, "Failed to update DirectusSchema status")
+	}
+	return err
+}
+
+func (r *DirectusSchemaReconciler) setSynced(schema *directusv1.DirectusSchema, status metav1.ConditionStatus, reason, message string) {
+	setSyncedCondition(&schema.Status.Conditions, schema.Generation, status, reason, message)
+}
+
+// schemasForConfigMap maps a ConfigMap to the schemas reading their snapshot from it
+func (r *DirectusSchemaReconciler) schemasForConfigMap(ctx context.Context, obj client.Object) []reconcile.Request {
+	schemas := &directusv1.DirectusSchemaList{}
+	if err := r.List(ctx, schemas, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, schema := range schemas.Items {
+		if schema.Spec.SnapshotFrom != nil && schema.Spec.SnapshotFrom.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&schema)})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DirectusSchemaReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&directusv1.DirectusSchema{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.schemasForConfigMap)).
+		Named("directusschema").
+		Complete(r)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// createRunningDirectus creates a Directus instance reported as running, with
+// the admin password the operator logs in with
+func createRunningDirectus(ctx context.Context, name string) {
+	directus := &directusv1.Directus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+	}
+	Expect(k8sClient.Create(ctx, directus)).To(Succeed())
+	directus.Status.Phase = "Running"
+	Expect(k8sClient.Status().Update(ctx, directus)).To(Succeed())
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + "-application-secret",
+			Namespace: "default",
+		},
+		StringData: map[string]string{"ADMIN_PASSWORD": "secret"},
+	}
+	Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+}
+
+// deleteDirectus removes an instance created by createRunningDirectus
+func deleteDirectus(ctx context.Context, name string) {
+	directus := &directusv1.Directus{}
+	Expect(k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, directus)).To(Succeed())
+	Expect(k8sClient.Delete(ctx, directus)).To(Succeed())
+	secret := &corev1.Secret{}
+	Expect(k8sClient.Get(ctx, types.NamespacedName{Name: name + "-application-secret", Namespace: "default"}, secret)).To(Succeed())
+	Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+}
+
+// newFakeDirectus returns a fake Directus API server accepting any admin login
+func newFakeDirectus() (*httptest.Server, *http.ServeMux) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /auth/login", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"access_token":"token"}}`))
+	})
+	return httptest.NewServer(mux), mux
+}
+
+var _ = Describe("DirectusSchema Controller", func() {
+	Context("When reconciling a resource", func() {
+		const directusName = "schema-directus"
+		const resourceName = "schema"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createRunningDirectus(ctx, directusName)
+
+			schema := &directusv1.DirectusSchema{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSchemaSpec{
+					DirectusRef: corev1.LocalObjectReference{Name: directusName},
+					Snapshot:    &runtime.RawExtension{Raw: []byte(`{"version":1,"collections":[]}`)},
+					DryRun:      true,
+				},
+			}
+			Expect(k8sClient.Create(ctx, schema)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			schema := &directusv1.DirectusSchema{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, schema)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, schema)).To(Succeed())
+			deleteDirectus(ctx, directusName)
+		})
+
+		It("should report drift in dry run and apply it otherwise", func() {
+			applied := false
+			server, mux := newFakeDirectus()
+			defer server.Close()
+			mux.HandleFunc("POST /schema/diff", func(w http.ResponseWriter, r *http.Request) {
+				if applied {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				_, _ = w.Write([]byte(`{"data":{"hash":"abc","diff":{"collections":[{"collection":"articles","diff":[{"kind":"N"}]}],"fields":[],"relations":[]}}}`))
+			})
+			mux.HandleFunc("POST /schema/apply", func(w http.ResponseWriter, r *http.Request) {
+				applied = true
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			controllerReconciler := &DirectusSchemaReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			schema := &directusv1.DirectusSchema{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, schema)).To(Succeed())
+			Expect(applied).To(BeFalse())
+			Expect(schema.Status.InSync).To(BeFalse())
+			Expect(schema.Status.Changes).To(ConsistOf("create collection articles"))
+			Expect(meta.IsStatusConditionFalse(schema.Status.Conditions, directusv1.ConditionSynced)).To(BeTrue())
+
+			By("disabling the dry run")
+			schema.Spec.DryRun = false
+			Expect(k8sClient.Update(ctx, schema)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, schema)).To(Succeed())
+			Expect(applied).To(BeTrue())
+			Expect(schema.Status.InSync).To(BeTrue())
+			Expect(schema.Status.Changes).To(BeEmpty())
+			Expect(schema.Status.LastAppliedTime).NotTo(BeNil())
+			Expect(meta.IsStatusConditionTrue(schema.Status.Conditions, directusv1.ConditionSynced)).To(BeTrue())
+		})
+	})
+})
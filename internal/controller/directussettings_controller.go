@@ -0,0 +1,284 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+	"github.com/example/directus-operator/internal/directusapi"
+)
+
+// DirectusSettingsReconciler reconciles a DirectusSettings object
+type DirectusSettingsReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	API      DirectusAPI
+}
+
+// +kubebuilder:rbac:groups=directus.example.com,resources=directussettings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=directus.example.com,resources=directussettings/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directussettings/finalizers,verbs=update
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusroles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directuses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// Reconcile applies the project settings and translations to the referenced
+// instance once it is running, and again whenever they were changed in the
+// admin app. Deleting the resource leaves the settings in place.
+func (r *DirectusSettingsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var settings directusv1.DirectusSettings
+	if err := r.Get(ctx, req.NamespacedName, &settings); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	_, api, err := r.API.connectInstance(ctx, r.Client, settings.Namespace, settings.Spec.DirectusRef)
+	if err == nil {
+		var changes []string
+		if changes, err = r.syncSettings(ctx, api, &settings); err == nil {
+			var translations []string
+			if translations, err = syncTranslations(ctx, api, settings.Spec.Translations); err == nil {
+				changes = append(changes, translations...)
+			}
+		}
+		if len(changes) > 0 {
+			logf.FromContext(ctx).Info("Applied settings", "changes", changes)
+			r.Recorder.Eventf(&settings, corev1.EventTypeNormal, ReasonUpdated, "Applied %v", changes)
+		}
+	}
+	if notReady, ok := err.(*instanceNotReadyError); ok {
+		setSyncedCondition(&settings.Status.Conditions, settings.Generation, metav1.ConditionUnknown, notReady.reason, notReady.message)
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, r.Status().Update(ctx, &settings)
+	} else if err != nil {
+		return ctrl.Result{}, r.reportError(ctx, &settings, err)
+	}
+
+	settings.Status.ObservedGeneration = settings.Generation
+	setSyncedCondition(&settings.Status.Conditions, settings.Generation, metav1.ConditionTrue, "Synced", "Settings match the resource")
+	return ctrl.Result{RequeueAfter: defaultSyncInterval}, r.Status().Update(ctx, &settings)
+}
+
+// syncSettings updates the project settings that differ from the spec and
+// returns the names of the changed settings
+func (r *DirectusSettingsReconciler) syncSettings(ctx context.Context, api *directusapi.Client, settings *directusv1.DirectusSettings) ([]string, error) {
+	spec := settings.Spec
+	desired := &directusapi.Settings{
+		PublicRegistration: spec.PublicRegistration,
+		AuthLoginAttempts:  spec.AuthLoginAttempts,
+	}
+	for field, value := range map[**string]string{
+		&desired.ProjectName:       spec.ProjectName,
+		&desired.ProjectDescriptor: spec.ProjectDescriptor,
+		&desired.ProjectURL:        spec.ProjectURL,
+		&desired.ProjectColor:      spec.ProjectColor,
+		&desired.DefaultLanguage:   spec.DefaultLanguage,
+	} {
+		if value != "" {
+			*field = ptr.To(value)
+		}
+	}
+	if ref := spec.PublicRegistrationRoleRef; ref != nil {
+		role := &directusv1.DirectusRole{}
+		err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: settings.Namespace}, role)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if err != nil || role.Status.ID == "" {
+			return nil, &instanceNotReadyError{"RoleNotReady", fmt.Sprintf("Waiting for DirectusRole %s to be synced", ref.Name)}
+		}
+		desired.PublicRegistrationRole = &role.Status.ID
+	}
+
+	current, err := api.GetSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.LogoURL == "" {
+		settings.Status.LogoURL, settings.Status.LogoFileID = "", ""
+	} else {
+		if err := r.importLogo(ctx, api, settings); err != nil {
+			return nil, err
+		}
+		desired.ProjectLogo = &settings.Status.LogoFileID
+	}
+
+	changed, err := changedSettings(current, desired)
+	if err != nil || len(changed) == 0 {
+		return nil, err
+	}
+	if err := api.UpdateSettings(ctx, desired); err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
+
+// logoTitle returns the title the logo is imported with. It is derived from
+// the URL, so a logo imported before its file ID was recorded is found again.
+func logoTitle(logoURL string) string {
+	sum := sha256.Sum256([]byte(logoURL))
+	return "Project logo " + hex.EncodeToString(sum[:])[:12]
+}
+
+// importLogo imports the logo when its URL changed or the file was deleted.
+// A file imported from the same URL before is reused.
+func (r *DirectusSettingsReconciler) importLogo(ctx context.Context, api *directusapi.Client, settings *directusv1.DirectusSettings) error {
+	status := &settings.Status
+	if status.LogoURL == settings.Spec.LogoURL && status.LogoFileID != "" {
+		_, err := directusapi.GetItem[directusapi.File](ctx, api, "/files", status.LogoFileID)
+		if !directusapi.IsNotFound(err) {
+			return err
+		}
+	}
+
+	title := logoTitle(settings.Spec.LogoURL)
+	existing, err := directusapi.ListItems[directusapi.File](ctx, api, "/files", url.Values{
+		"filter[title][_eq]": {title},
+		"fields":             {"id,title"},
+		"limit":              {"1"},
+	})
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		status.LogoURL, status.LogoFileID = settings.Spec.LogoURL, existing[0].ID
+		return nil
+	}
+
+	file, err := api.ImportFile(ctx, settings.Spec.LogoURL, title)
+	if err != nil {
+		return fmt.Errorf("failed to import logo: %w", err)
+	}
+	status.LogoURL, status.LogoFileID = settings.Spec.LogoURL, file.ID
+	r.Recorder.Eventf(settings, corev1.EventTypeNormal, ReasonCreated, "Imported logo from %s as file %s", settings.Spec.LogoURL, file.ID)
+	return nil
+}
+
+// changedSettings returns the JSON names of the settings set in desired that differ from current
+func changedSettings(current, desired *directusapi.Settings) ([]string, error) {
+	var currentFields, desiredFields map[string]any
+	for settings, fields := range map[*directusapi.Settings]*map[string]any{current: &currentFields, desired: &desiredFields} {
+		raw, err := json.Marshal(settings)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, fields); err != nil {
+			return nil, err
+		}
+	}
+
+	var changed []string
+	for field, value := range desiredFields {
+		if !reflect.DeepEqual(currentFields[field], value) {
+			changed = append(changed, field)
+		}
+	}
+	slices.Sort(changed)
+	return changed, nil
+}
+
+// syncTranslations creates or updates the translation strings and returns the
+// changed ones. Strings not in the spec are left alone.
+func syncTranslations(ctx context.Context, api *directusapi.Client, translations []directusv1.DirectusTranslation) ([]string, error) {
+	if len(translations) == 0 {
+		return nil, nil
+	}
+	current, err := directusapi.ListItems[directusapi.Translation](ctx, api, "/translations", url.Values{"limit": {"-1"}})
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]directusapi.Translation{}
+	for _, translation := range current {
+		existing[translation.Language+"/"+translation.Key] = translation
+	}
+
+	var changed []string
+	for _, spec := range translations {
+		name := spec.Language + "/" + spec.Key
+		desired := directusapi.Translation{Key: spec.Key, Language: spec.Language, Value: spec.Value}
+		found, ok := existing[name]
+		switch {
+		case !ok:
+			_, err = directusapi.CreateItem(ctx, api, "/translations", &desired)
+		case found.Value != desired.Value:
+			_, err = directusapi.UpdateItem[directusapi.Translation](ctx, api, "/translations", found.ID, &desired)
+		default:
+			continue
+		}
+		if err != nil {
+			return changed, fmt.Errorf("failed to sync translation %s: %w", name, err)
+		}
+		changed = append(changed, "translation "+name)
+	}
+	return changed, nil
+}
+
+// reportError marks the settings as not synced and returns err for a retry
+func (r *DirectusSettingsReconciler) reportError(ctx context.Context, settings *directusv1.DirectusSettings, err error) error {
+	r.Recorder.Eventf(settings, corev1.EventTypeWarning, "SyncFailed", "%v", err)
+	setSyncedCondition(&settings.Status.Conditions, settings.Generation, metav1.ConditionFalse, "APIError", err.Error())
+	if statusErr := r.Status().Update(ctx, settings); statusErr != nil {
+		logf.FromContext(ctx).Error(statusErr, "Failed to update DirectusSettings status")
+	}
+	return err
+}
+
+// settingsForRole maps a role to the settings using it for public registration
+func (r *DirectusSettingsReconciler) settingsForRole(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &directusv1.DirectusSettingsList{}
+	if err := r.List(ctx, list, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, settings := range list.Items {
+		if ref := settings.Spec.PublicRegistrationRoleRef; ref != nil && ref.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&settings)})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DirectusSettingsReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&directusv1.DirectusSettings{}).
+		Watches(&directusv1.DirectusRole{}, handler.EnqueueRequestsFromMapFunc(r.settingsForRole)).
+		Named("directussettings").
+		Complete(r)
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+var _ = Describe("DirectusSettings Controller", func() {
+	Context("When reconciling a resource", func() {
+		const directusName = "settings-directus"
+		const resourceName = "branding"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createRunningDirectus(ctx, directusName)
+
+			settings := &directusv1.DirectusSettings{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusSettingsSpec{
+					DirectusRef:        corev1.LocalObjectReference{Name: directusName},
+					ProjectName:        "Acme",
+					ProjectColor:       "#FF5733",
+					PublicRegistration: ptr.To(false),
+					Translations: []directusv1.DirectusTranslation{
+						{Key: "welcome", Language: "en-US", Value: "Welcome"},
+						{Key: "welcome", Language: "de-DE", Value: "Willkommen"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, settings)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			settings := &directusv1.DirectusSettings{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, settings)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, settings)).To(Succeed())
+			deleteDirectus(ctx, directusName)
+		})
+
+		It("should apply the settings and revert changes made in the admin app", func() {
+			var mu sync.Mutex
+			current := map[string]any{"project_name": "Directus", "project_color": "#6644FF", "public_registration": false}
+			translations := []map[string]any{{"id": "t-1", "key": "welcome", "language": "en-US", "value": "Hello"}}
+			patches := 0
+
+			server, mux := newFakeDirectus()
+			defer server.Close()
+			mux.HandleFunc("GET /settings", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				_ = json.NewEncoder(w).Encode(map[string]any{"data": current})
+			})
+			mux.HandleFunc("PATCH /settings", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				var body map[string]any
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				for key, value := range body {
+					current[key] = value
+				}
+				patches++
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			})
+			mux.HandleFunc("GET /translations", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				_ = json.NewEncoder(w).Encode(map[string]any{"data": translations})
+			})
+			mux.HandleFunc("POST /translations", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				var body map[string]any
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				body["id"] = "t-2"
+				translations = append(translations, body)
+				_ = json.NewEncoder(w).Encode(map[string]any{"data": body})
+			})
+			mux.HandleFunc("PATCH /translations/t-1", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				Expect(json.NewDecoder(r.Body).Decode(&translations[0])).To(Succeed())
+				_ = json.NewEncoder(w).Encode(map[string]any{"data": translations[0]})
+			})
+
+			controllerReconciler := &DirectusSettingsReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			settings := &directusv1.DirectusSettings{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, settings)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(settings.Status.Conditions, directusv1.ConditionSynced)).To(BeTrue())
+			Expect(current).To(HaveKeyWithValue("project_name", "Acme"))
+			Expect(current).To(HaveKeyWithValue("project_color", "#FF5733"))
+			Expect(patches).To(Equal(1))
+			Expect(translations).To(ConsistOf(
+				HaveKeyWithValue("value", "Welcome"),
+				HaveKeyWithValue("value", "Willkommen"),
+			))
+
+			By("leaving matching settings untouched")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(patches).To(Equal(1))
+
+			By("reverting a change made in the admin app")
+			mu.Lock()
+			current["project_name"] = "Renamed"
+			mu.Unlock()
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(current).To(HaveKeyWithValue("project_name", "Acme"))
+			Expect(patches).To(Equal(2))
+		})
+
+		It("should reuse a logo imported before its file ID was recorded", func() {
+			const logoURL = "https://acme.example.com/logo.png"
+			var mu sync.Mutex
+			current := map[string]any{}
+			imports := 0
+			var filter string
+
+			server, mux := newFakeDirectus()
+			defer server.Close()
+			mux.HandleFunc("GET /settings", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				_ = json.NewEncoder(w).Encode(map[string]any{"data": current})
+			})
+			mux.HandleFunc("PATCH /settings", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				Expect(json.NewDecoder(r.Body).Decode(&current)).To(Succeed())
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			})
+			mux.HandleFunc("GET /translations", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":[{"id":"t-1","key":"welcome","language":"en-US","value":"Welcome"},` +
+					`{"id":"t-2","key":"welcome","language":"de-DE","value":"Willkommen"}]}`))
+			})
+			mux.HandleFunc("GET /files", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				filter = r.URL.Query().Get("filter[title][_eq]")
+				_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{{"id": "file-1", "title": filter}}})
+			})
+			mux.HandleFunc("POST /files/import", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				imports++
+				_, _ = w.Write([]byte(`{"data":{"id":"file-2"}}`))
+			})
+
+			settings := &directusv1.DirectusSettings{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, settings)).To(Succeed())
+			settings.Spec.LogoURL = logoURL
+			Expect(k8sClient.Update(ctx, settings)).To(Succeed())
+
+			controllerReconciler := &DirectusSettingsReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filter).To(Equal(logoTitle(logoURL)))
+			Expect(imports).To(BeZero())
+			Expect(current).To(HaveKeyWithValue("project_logo", "file-1"))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, settings)).To(Succeed())
+			Expect(settings.Status.LogoFileID).To(Equal("file-1"))
+		})
+	})
+})
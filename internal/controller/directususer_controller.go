@@ -0,0 +1,342 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+	"github.com/example/directus-operator/internal/directusapi"
+)
+
+// DirectusUserReconciler reconciles a DirectusUser object
+type DirectusUserReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	API      DirectusAPI
+}
+
+// +kubebuilder:rbac:groups=directus.example.com,resources=directususers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=directus.example.com,resources=directususers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directususers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=directus.example.com,resources=directusroles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=directus.example.com,resources=directuses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile creates or updates the user in the referenced instance and keeps
+// its static token in a Secret, generating a new token whenever the Secret is
+// missing. The user is deleted from Directus when the resource is deleted.
+func (r *DirectusUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var user directusv1.DirectusUser
+	if err := r.Get(ctx, req.NamespacedName, &user); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !user.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&user, directusObjectFinalizer) {
+			return ctrl.Result{}, nil
+		}
+		// Adopted users existed before the resource and are kept
+		if user.Status.Created {
+			if err := r.API.deleteDirectusObject(ctx, r.Client, user.Namespace, user.Spec.DirectusRef, "/users", user.Status.ID); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		controllerutil.RemoveFinalizer(&user, directusObjectFinalizer)
+		return ctrl.Result{}, r.Update(ctx, &user)
+	}
+
+	if controllerutil.AddFinalizer(&user, directusObjectFinalizer) {
+		if err := r.Update(ctx, &user); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	directus, api, err := r.API.connectInstance(ctx, r.Client, user.Namespace, user.Spec.DirectusRef)
+	if err == nil {
+		var roleID *string
+		if roleID, err = r.roleID(ctx, &user); err == nil {
+			var created bool
+			if created, err = r.syncUser(ctx, api, directus, &user, roleID); err == nil {
+				err = r.syncToken(ctx, api, directus, &user, created)
+			}
+		}
+	}
+	if notReady, ok := err.(*instanceNotReadyError); ok {
+		setSyncedCondition(&user.Status.Conditions, user.Generation, metav1.ConditionUnknown, notReady.reason, notReady.message)
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, r.Status().Update(ctx, &user)
+	} else if err != nil {
+		return ctrl.Result{}, r.reportError(ctx, &user, err)
+	}
+
+	user.Status.ObservedGeneration = user.Generation
+	setSyncedCondition(&user.Status.Conditions, user.Generation, metav1.ConditionTrue, "Synced", "User matches the resource")
+	return ctrl.Result{RequeueAfter: defaultSyncInterval}, r.Status().Update(ctx, &user)
+}
+
+// roleID returns the Directus ID of the referenced role, nil without a role
+func (r *DirectusUserReconciler) roleID(ctx context.Context, user *directusv1.DirectusUser) (*string, error) {
+	ref := user.Spec.RoleRef
+	if ref == nil {
+		return nil, nil
+	}
+	role := &directusv1.DirectusRole{}
+	err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: user.Namespace}, role)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err != nil || role.Status.ID == "" {
+		return nil, &instanceNotReadyError{"RoleNotReady", fmt.Sprintf("Waiting for DirectusRole %s to be synced", ref.Name)}
+	}
+	return &role.Status.ID, nil
+}
+
+// errAdminUser is returned for a user with the email of the admin user, which
+// the Directus resource manages
+var errAdminUser = stderrors.New("the admin user of the instance cannot be managed by a DirectusUser")
+
+// syncUser creates or updates the user and reports whether it was created
+func (r *DirectusUserReconciler) syncUser(ctx context.Context, api *directusapi.Client, directus *directusv1.Directus, user *directusv1.DirectusUser, roleID *string) (bool, error) {
+	if strings.EqualFold(user.Spec.Email, adminEmail(directus)) {
+		return false, errAdminUser
+	}
+
+	desired := directusapi.User{
+		Email:     user.Spec.Email,
+		FirstName: user.Spec.FirstName,
+		LastName:  user.Spec.LastName,
+		Role:      roleID,
+		Status:    "active",
+	}
+	if user.Spec.Suspended {
+		desired.Status = "suspended"
+	}
+
+	current, err := findItem[directusapi.User](ctx, api, "/users", user.Status.ID, "email", desired.Email)
+	if err != nil {
+		return false, err
+	}
+
+	if current == nil {
+		created, err := directusapi.CreateItem(ctx, api, "/users", &desired)
+		if err != nil {
+			return false, err
+		}
+		// Record the user right away, a retry would otherwise find it by email
+		// and take it for a user the operator did not create
+		user.Status.ID = created.ID
+		user.Status.Created = true
+		if err := r.Status().Update(ctx, user); err != nil {
+			return false, err
+		}
+		r.Recorder.Eventf(user, corev1.EventTypeNormal, ReasonCreated, "Created user %s", created.ID)
+		return true, nil
+	}
+
+	if err := checkAdoption("user", desired.Email, current.ID, user.Status.ID, user.Spec.Adopt); err != nil {
+		return false, err
+	}
+	if current.ID != user.Status.ID {
+		user.Status.Created = false
+	}
+	user.Status.ID = current.ID
+	desired.ID = current.ID
+	if !userEqual(current, &desired) {
+		if _, err := directusapi.UpdateItem[directusapi.User](ctx, api, "/users", current.ID, &desired); err != nil {
+			return false, err
+		}
+		r.Recorder.Eventf(user, corev1.EventTypeNormal, ReasonUpdated, "Updated user %s", current.ID)
+	}
+	return false, nil
+}
+
+// syncToken generates a static token when the token Secret does not exist or
+// the user was just created. Directus never returns tokens, so an existing
+// Secret is trusted otherwise. Removing the token from the spec revokes it and
+// deletes its Secret.
+func (r *DirectusUserReconciler) syncToken(ctx context.Context, api *directusapi.Client, directus *directusv1.Directus, user *directusv1.DirectusUser, rotate bool) error {
+	spec := user.Spec.Token
+	previous := user.Status.TokenSecretName
+	if spec == nil {
+		if previous != "" {
+			if _, err := directusapi.UpdateItem[directusapi.User](ctx, api, "/users", user.Status.ID, map[string]any{"token": nil}); err != nil {
+				return fmt.Errorf("failed to revoke token of user %s: %w", user.Status.ID, err)
+			}
+			if err := r.deleteTokenSecret(ctx, user, previous); err != nil {
+				return err
+			}
+		}
+		user.Status.TokenSecretName = ""
+		return nil
+	}
+
+	secretName := spec.SecretName
+	if secretName == "" {
+		secretName = user.Name + "-token"
+	}
+	key := spec.Key
+	if key == "" {
+		key = "token"
+	}
+	if previous != "" && previous != secretName {
+		if err := r.deleteTokenSecret(ctx, user, previous); err != nil {
+			return err
+		}
+	}
+	user.Status.TokenSecretName = secretName
+
+	found := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: secretName, Namespace: user.Namespace}, found)
+	if err == nil {
+		if !metav1.IsControlledBy(found, user) {
+			return fmt.Errorf("secret %s exists and is not managed by this DirectusUser", secretName)
+		}
+		if _, ok := found.Data[key]; ok && !rotate {
+			return nil
+		}
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+
+	token, err := randomToken(32)
+	if err != nil {
+		return err
+	}
+	if _, err := directusapi.UpdateItem[directusapi.User](ctx, api, "/users", user.Status.ID, map[string]string{"token": token}); err != nil {
+		return fmt.Errorf("failed to set token of user %s: %w", user.Status.ID, err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: user.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "directus",
+				"app.kubernetes.io/instance":   directus.Name,
+				"app.kubernetes.io/managed-by": "directus-operator",
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			key:   []byte(token),
+			"url": []byte(r.API.baseURL(directus)),
+		},
+	}
+	if err := controllerutil.SetControllerReference(user, secret, r.Scheme); err != nil {
+		return err
+	}
+	if found.Name != "" {
+		found.Data = secret.Data
+		err = r.Update(ctx, found)
+	} else {
+		err = r.Create(ctx, secret)
+	}
+	if err != nil {
+		return err
+	}
+
+	user.Status.TokenRotationTime = ptr.To(metav1.Now())
+	r.Recorder.Eventf(user, corev1.EventTypeNormal, ReasonSecretGenerated, "Generated static token in Secret %s", secretName)
+	return nil
+}
+
+// deleteTokenSecret deletes a token Secret written for the user. Secrets the
+// user does not control are left alone.
+func (r *DirectusUserReconciler) deleteTokenSecret(ctx context.Context, user *directusv1.DirectusUser, name string) error {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: user.Namespace}, secret); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(secret, user) {
+		return nil
+	}
+	if err := r.Delete(ctx, secret); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	r.Recorder.Eventf(user, corev1.EventTypeNormal, ReasonDeleted, "Deleted Secret %s", name)
+	return nil
+}
+
+// reportError marks the user as not synced and returns err for a retry
+func (r *DirectusUserReconciler) reportError(ctx context.Context, user *directusv1.DirectusUser, err error) error {
+	r.Recorder.Eventf(user, corev1.EventTypeWarning, "SyncFailed", "%v", err)
+	setSyncedCondition(&user.Status.Conditions, user.Generation, metav1.ConditionFalse, syncErrorReason(err), err.Error())
+	if statusErr := r.Status().Update(ctx, user); statusErr != nil {
+		logf.FromContext(ctx).Error(statusErr, "Failed to update DirectusUser status")
+	}
+	return err
+}
+
+func userEqual(a, b *directusapi.User) bool {
+	return a.Email == b.Email && a.FirstName == b.FirstName && a.LastName == b.LastName &&
+		ptr.Deref(a.Role, "") == ptr.Deref(b.Role, "") && a.Status == b.Status
+}
+
+// randomToken returns a URL-safe random string of n random bytes
+func randomToken(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// usersForRole maps a role to the users it is assigned to
+func (r *DirectusUserReconciler) usersForRole(ctx context.Context, obj client.Object) []reconcile.Request {
+	users := &directusv1.DirectusUserList{}
+	if err := r.List(ctx, users, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, user := range users.Items {
+		if user.Spec.RoleRef != nil && user.Spec.RoleRef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&user)})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DirectusUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&directusv1.DirectusUser{}).
+		Owns(&corev1.Secret{}).
+		Watches(&directusv1.DirectusRole{}, handler.EnqueueRequestsFromMapFunc(r.usersForRole)).
+		Named("directususer").
+		Complete(r)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+var _ = Describe("DirectusUser Controller", func() {
+	Context("When reconciling a resource", func() {
+		const directusName = "user-directus"
+		const resourceName = "reporting"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		secretNamespacedName := types.NamespacedName{
+			Name:      resourceName + "-token",
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createRunningDirectus(ctx, directusName)
+
+			user := &directusv1.DirectusUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: directusv1.DirectusUserSpec{
+					DirectusRef: corev1.LocalObjectReference{Name: directusName},
+					Email:       "reporting@example.com",
+					Token:       &directusv1.DirectusUserToken{},
+				},
+			}
+			Expect(k8sClient.Create(ctx, user)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			user := &directusv1.DirectusUser{}
+			if err := k8sClient.Get(ctx, typeNamespacedName, user); err == nil {
+				user.Finalizers = nil
+				Expect(k8sClient.Update(ctx, user)).To(Succeed())
+				Expect(k8sClient.Delete(ctx, user)).To(Succeed())
+			}
+
+			secret := &corev1.Secret{}
+			if err := k8sClient.Get(ctx, secretNamespacedName, secret); err == nil {
+				Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+			}
+			deleteDirectus(ctx, directusName)
+		})
+
+		It("should create the user and rotate its token when the Secret is deleted", func() {
+			var tokens []string
+			server, mux := newFakeDirectus()
+			defer server.Close()
+			mux.HandleFunc("GET /users", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("filter[email][_eq]")).To(Equal("reporting@example.com"))
+				_, _ = w.Write([]byte(`{"data":[]}`))
+			})
+			mux.HandleFunc("POST /users", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":{"id":"user-1","email":"reporting@example.com","status":"active"}}`))
+			})
+			mux.HandleFunc("GET /users/user-1", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":{"id":"user-1","email":"reporting@example.com","status":"active"}}`))
+			})
+			mux.HandleFunc("PATCH /users/user-1", func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]string
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				tokens = append(tokens, body["token"])
+				_, _ = w.Write([]byte(`{"data":{"id":"user-1"}}`))
+			})
+
+			controllerReconciler := &DirectusUserReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			user := &directusv1.DirectusUser{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, user)).To(Succeed())
+			Expect(user.Status.ID).To(Equal("user-1"))
+			Expect(user.Status.Created).To(BeTrue())
+			Expect(user.Status.TokenSecretName).To(Equal(resourceName + "-token"))
+			Expect(meta.IsStatusConditionTrue(user.Status.Conditions, directusv1.ConditionSynced)).To(BeTrue())
+
+			Expect(tokens).To(HaveLen(1))
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, secretNamespacedName, secret)).To(Succeed())
+			Expect(string(secret.Data["token"])).To(Equal(tokens[0]))
+			Expect(metav1.IsControlledBy(secret, user)).To(BeTrue())
+
+			By("keeping the token while the Secret exists")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(HaveLen(1))
+
+			By("deleting the Secret")
+			Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(tokens).To(HaveLen(2))
+			Expect(tokens[1]).NotTo(Equal(tokens[0]))
+			Expect(k8sClient.Get(ctx, secretNamespacedName, secret)).To(Succeed())
+			Expect(string(secret.Data["token"])).To(Equal(tokens[1]))
+
+			By("removing the token from the spec")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, user)).To(Succeed())
+			user.Spec.Token = nil
+			Expect(k8sClient.Update(ctx, user)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(tokens).To(HaveLen(3))
+			Expect(tokens[2]).To(BeEmpty())
+			err = k8sClient.Get(ctx, secretNamespacedName, secret)
+			Expect(errors.IsNotFound(err) || secret.DeletionTimestamp != nil).To(BeTrue())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, user)).To(Succeed())
+			Expect(user.Status.TokenSecretName).To(BeEmpty())
+		})
+
+		It("should refuse the admin user and only adopt existing users when asked", func() {
+			deleted := false
+			server, mux := newFakeDirectus()
+			defer server.Close()
+			mux.HandleFunc("GET /users", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":[{"id":"user-7","email":"reporting@example.com","status":"active"}]}`))
+			})
+			mux.HandleFunc("GET /users/user-7", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":{"id":"user-7","email":"reporting@example.com","status":"active"}}`))
+			})
+			mux.HandleFunc("PATCH /users/user-7", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":{"id":"user-7"}}`))
+			})
+			mux.HandleFunc("DELETE /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+				deleted = true
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			controllerReconciler := &DirectusUserReconciler{
+				Client:   k8sClient,
+				Scheme:   k8sClient.Scheme(),
+				Recorder: record.NewFakeRecorder(10),
+				API: DirectusAPI{
+					URLFor: func(*directusv1.Directus) string { return server.URL },
+				},
+			}
+			syncedReason := func() string {
+				user := &directusv1.DirectusUser{}
+				Expect(k8sClient.Get(ctx, typeNamespacedName, user)).To(Succeed())
+				return meta.FindStatusCondition(user.Status.Conditions, directusv1.ConditionSynced).Reason
+			}
+
+			By("using the email of the admin user")
+			user := &directusv1.DirectusUser{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, user)).To(Succeed())
+			user.Spec.Email = "Directus-Admin@example.com"
+			Expect(k8sClient.Update(ctx, user)).To(Succeed())
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(errAdminUser))
+			Expect(syncedReason()).To(Equal("AdminUser"))
+
+			By("using the email of an existing user")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, user)).To(Succeed())
+			user.Spec.Email = "reporting@example.com"
+			Expect(k8sClient.Update(ctx, user)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(HaveOccurred())
+			Expect(syncedReason()).To(Equal("AlreadyExists"))
+
+			By("opting in to adoption")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, user)).To(Succeed())
+			user.Spec.Adopt = true
+			Expect(k8sClient.Update(ctx, user)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, user)).To(Succeed())
+			Expect(user.Status.ID).To(Equal("user-7"))
+			Expect(user.Status.Created).To(BeFalse())
+
+			By("deleting the resource")
+			Expect(k8sClient.Delete(ctx, user)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,217 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	directusv1 "github.com/example/directus-operator/api/v1"
+)
+
+// pvcFetchScript copies the dump, and the uploads archive when requested, from the claim to /work
+const pvcFetchScript = `set -eu
+cp "/backup/${DUMP_PATH}" /work/dump.sql.gz
+UPLOADS="/backup/${DUMP_PATH%.sql.gz}-uploads.tar.gz"
+if [ "$FETCH_UPLOADS" = "true" ] && [ -f "$UPLOADS" ]; then cp "$UPLOADS" /work/uploads.tar.gz; fi
+`
+
+// s3FetchScript downloads the dump, and the uploads archive when requested, to /work
+const s3FetchScript = `set -eu
+aws s3 cp "$LOCATION" /work/dump.sql.gz
+if [ "$FETCH_UPLOADS" = "true" ]; then
+  aws s3 cp "${LOCATION%.sql.gz}-uploads.tar.gz" /work/uploads.tar.gz || true
+fi
+`
+
+// extractUploadsScript replaces the uploads folder with the archive, if one was fetched
+const extractUploadsScript = `set -eu
+if [ -f /work/uploads.tar.gz ]; then
+  find /uploads -mindepth 1 -delete
+  tar -xzf /work/uploads.tar.gz -C /uploads
+fi
+`
+
+// restoreScript loads the dump into the database
+const restoreScript = `set -eu
+case "$DUMP_TOOL" in
+  pg_dump)
+    gunzip -c /work/dump.sql.gz | PGPASSWORD="${DB_PASSWORD:-}" psql -v ON_ERROR_STOP=1 -q \
+      -h "$DB_HOST" -p "$DB_PORT" -U "$DB_USER" -d "$DB_DATABASE" ;;
+  mysqldump)
+    gunzip -c /work/dump.sql.gz | MYSQL_PWD="${DB_PASSWORD:-}" mysql \
+      -h "$DB_HOST" -P "$DB_PORT" -u "$DB_USER" "$DB_DATABASE" ;;
+esac
+`
+
+// restoreOptions describes a single restore run
+type restoreOptions struct {
+	Location         string
+	Storage          directusv1.DirectusBackupStorage
+	Image            string
+	UploadsClaimName string
+	Resources        corev1.ResourceRequirements
+}
+
+// parseBackupLocation splits a backup location into its scheme, the claim or
+// bucket name and the path within it
+func parseBackupLocation(location string) (scheme, name, path string, err error) {
+	scheme, rest, ok := strings.Cut(location, "://")
+	if !ok || (scheme != "pvc" && scheme != "s3") {
+		return "", "", "", fmt.Errorf("backup location %q must start with pvc:// or s3://", location)
+	}
+	name, path, _ = strings.Cut(rest, "/")
+	if name == "" || !strings.HasSuffix(path, ".sql.gz") {
+		return "", "", "", fmt.Errorf("backup location %q does not reference a database dump", location)
+	}
+	return scheme, name, path, nil
+}
+
+// buildRestorePodSpec returns the pod restoring a backup: init containers fetch
+// the dump and replace the uploads, then the restore container loads the dump
+func buildRestorePodSpec(directus *directusv1.Directus, opts restoreOptions) (*corev1.PodSpec, error) {
+	tool := dumpTool(directus.Spec.Database.Engine)
+	if tool == "" {
+		return nil, fmt.Errorf("database engine %q does not support backups", directus.Spec.Database.Engine)
+	}
+	scheme, name, path, err := parseBackupLocation(opts.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	fetchUploads := fmt.Sprint(opts.UploadsClaimName != "")
+	volumes := []corev1.Volume{
+		{Name: "work", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+	}
+	fetch := corev1.Container{
+		Name:         "fetch",
+		Command:      []string{"/bin/sh", "-c"},
+		Env:          []corev1.EnvVar{{Name: "FETCH_UPLOADS", Value: fetchUploads}},
+		VolumeMounts: []corev1.VolumeMount{{Name: "work", MountPath: "/work"}},
+		Resources:    opts.Resources,
+	}
+
+	if scheme == "s3" {
+		fetch.Image = defaultAWSCLIImage
+		fetch.Args = []string{s3FetchScript}
+		fetch.Env = append(fetch.Env, corev1.EnvVar{Name: "LOCATION", Value: opts.Location})
+		if s3 := opts.Storage.S3; s3 != nil {
+			fetch.Env = append(fetch.Env, s3Env(s3, "")...)
+		}
+	} else {
+		fetch.Image = defaultBusyboxImage
+		fetch.Args = []string{pvcFetchScript}
+		fetch.Env = append(fetch.Env, corev1.EnvVar{Name: "DUMP_PATH", Value: path})
+		fetch.VolumeMounts = append(fetch.VolumeMounts, corev1.VolumeMount{Name: "backup", MountPath: "/backup", ReadOnly: true})
+		volumes = append(volumes, corev1.Volume{
+			Name: "backup",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: name,
+					ReadOnly:  true,
+				},
+			},
+		})
+	}
+
+	initContainers := []corev1.Container{fetch}
+	if opts.UploadsClaimName != "" {
+		volumes = append(volumes, corev1.Volume{
+			Name: "uploads",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: opts.UploadsClaimName,
+				},
+			},
+		})
+		initContainers = append(initContainers, corev1.Container{
+			Name:    "uploads",
+			Image:   defaultBusyboxImage,
+			Command: []string{"/bin/sh", "-c"},
+			Args:    []string{extractUploadsScript},
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "work", MountPath: "/work", ReadOnly: true},
+				{Name: "uploads", MountPath: "/uploads"},
+			},
+			Resources: opts.Resources,
+		})
+	}
+
+	return &corev1.PodSpec{
+		RestartPolicy:    corev1.RestartPolicyNever,
+		ImagePullSecrets: directus.Spec.ImagePullSecrets,
+		SecurityContext:  directus.Spec.PodSecurityContext,
+		InitContainers:   initContainers,
+		Containers: []corev1.Container{
+			{
+				Name:         "restore",
+				Image:        databaseClientImage(tool, opts.Image),
+				Command:      []string{"/bin/sh", "-c"},
+				Args:         []string{restoreScript},
+				Env:          append([]corev1.EnvVar{{Name: "DUMP_TOOL", Value: tool}}, databaseEnv(directus, tool)...),
+				VolumeMounts: []corev1.VolumeMount{{Name: "work", MountPath: "/work", ReadOnly: true}},
+				Resources:    opts.Resources,
+			},
+		},
+		Volumes: volumes,
+	}, nil
+}
+
+// buildMigrationPodTemplate returns the pod running the Directus database
+// migrations. It is the pod template of the Deployment, including the pod
+// annotations and spec.podTemplate, with only the Directus container running
+// the migrations. The Deployment's selector labels are removed so the pod is
+// not mistaken for a Directus replica.
+func buildMigrationPodTemplate(directus *directusv1.Directus) (*corev1.PodTemplateSpec, error) {
+	r := &DirectusReconciler{}
+	template, err := r.buildPodTemplate(directus)
+	if err != nil {
+		return nil, err
+	}
+
+	var container *corev1.Container
+	for i := range template.Spec.Containers {
+		if template.Spec.Containers[i].Name == "directus" {
+			container = &template.Spec.Containers[i]
+		}
+	}
+	if container == nil {
+		return nil, fmt.Errorf("spec.podTemplate removed the directus container")
+	}
+	container.Name = "migrate"
+	container.Command = []string{"node", "cli.js", "database", "migrate:latest"}
+	container.Ports = nil
+	container.LivenessProbe = nil
+	container.ReadinessProbe = nil
+	container.StartupProbe = nil
+	// Sidecars would keep the Job from completing
+	template.Spec.Containers = []corev1.Container{*container}
+	template.Spec.RestartPolicy = corev1.RestartPolicyNever
+	template.Spec.TopologySpreadConstraints = nil
+
+	for key := range r.getLabels(directus) {
+		delete(template.Labels, key)
+	}
+	if template.Annotations[vaultInjectAnnotation] == "true" {
+		// Only the init container renders the secrets, the agent sidecar would
+		// keep the Job from completing
+		template.Annotations[vaultPrePopulateOnlyAnnotation] = "true"
+	}
+	return template, nil
+}
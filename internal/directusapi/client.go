@@ -0,0 +1,185 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package directusapi contains a minimal client for the Directus REST API.
+package directusapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Client talks to the REST API of a single Directus instance
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+}
+
+// NewClient returns a client for the Directus instance served at baseURL
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// APIError is returned when Directus answers with a non-2xx status code
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("directus API returned %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is a Directus 404 (or 403, which Directus
+// returns for items that do not exist).
+func IsNotFound(err error) bool {
+	apiErr, ok := err.(*APIError)
+	return ok && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusForbidden)
+}
+
+// SetToken sets the bearer token used for subsequent requests
+func (c *Client) SetToken(token string) {
+	c.token = token
+}
+
+// Token returns the bearer token used for requests, empty when anonymous
+func (c *Client) Token() string {
+	return c.token
+}
+
+// Login authenticates with email and password and keeps the access token
+func (c *Client) Login(ctx context.Context, email, password string) error {
+	var out struct {
+		AccessToken string `json:"access_token"`
+	}
+	body := map[string]string{"email": email, "password": password}
+	if err := c.Do(ctx, http.MethodPost, "/auth/login", body, &out); err != nil {
+		return err
+	}
+	c.token = out.AccessToken
+	return nil
+}
+
+// HealthCheck is a single entry of the /server/health checks map
+type HealthCheck struct {
+	Status        string `json:"status"`
+	ComponentType string `json:"componentType,omitempty"`
+	Output        any    `json:"output,omitempty"`
+}
+
+// Health is the response of /server/health
+type Health struct {
+	Status string                   `json:"status"`
+	Checks map[string][]HealthCheck `json:"checks,omitempty"`
+}
+
+// Health returns the health report of the instance. Detailed checks are only
+// included when the client is authenticated as an admin.
+func (c *Client) Health(ctx context.Context) (*Health, error) {
+	health := &Health{}
+	// /server/health answers 503 with a valid body when a check fails
+	err := c.Do(ctx, http.MethodGet, "/server/health", nil, health)
+	if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusServiceUnavailable && health.Status != "" {
+		return health, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return health, nil
+}
+
+// Do sends a request to the API. Request bodies are encoded as JSON and
+// responses are decoded into out, unwrapping the "data" envelope Directus
+// uses for most endpoints.
+func (c *Client) Do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var apiErr error
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr = &APIError{StatusCode: resp.StatusCode, Message: errorMessage(raw)}
+	}
+
+	if out == nil || len(raw) == 0 || resp.StatusCode == http.StatusNoContent {
+		return apiErr
+	}
+
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &envelope); err == nil && len(envelope.Data) > 0 {
+		raw = envelope.Data
+	}
+	if err := json.Unmarshal(raw, out); err != nil && apiErr == nil {
+		return fmt.Errorf("failed to decode %s %s response: %w", method, path, err)
+	}
+	return apiErr
+}
+
+// errorMessage extracts the first error message from a Directus error body
+func errorMessage(raw []byte) string {
+	var body struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(raw, &body); err == nil && len(body.Errors) > 0 {
+		return body.Errors[0].Message
+	}
+	return strings.TrimSpace(string(raw))
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directusapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDirectusAPI(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "Directus API Suite")
+}
+
+var _ = Describe("Client", func() {
+	var (
+		ctx    context.Context
+		server *httptest.Server
+		mux    *http.ServeMux
+		api    *Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+		api = NewClient(server.URL, server.Client())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should log in and send the access token", func() {
+		mux.HandleFunc("POST /auth/login", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]string
+			Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			Expect(body).To(HaveKeyWithValue("email", "admin@example.com"))
+			_, _ = w.Write([]byte(`{"data":{"access_token":"token-123"}}`))
+		})
+		mux.HandleFunc("GET /server/health", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Header.Get("Authorization")).To(Equal("Bearer token-123"))
+			_, _ = w.Write([]byte(`{"status":"ok","checks":{"email:connection":[{"status":"ok","componentType":"email"}]}}`))
+		})
+
+		Expect(api.Login(ctx, "admin@example.com", "secret")).To(Succeed())
+		health, err := api.Health(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(health.Status).To(Equal("ok"))
+		Expect(health.Checks["email:connection"]).To(HaveLen(1))
+	})
+
+	It("should return the health report when a check fails", func() {
+		mux.HandleFunc("GET /server/health", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"status":"error","checks":{"email:connection":[{"status":"error"}]}}`))
+		})
+
+		health, err := api.Health(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(health.Checks["email:connection"][0].Status).To(Equal("error"))
+	})
+
+	It("should surface Directus error messages", func() {
+		mux.HandleFunc("GET /roles/missing", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"You don't have permission to access this."}]}`))
+		})
+
+		err := api.Do(ctx, http.MethodGet, "/roles/missing", nil, &map[string]any{})
+		Expect(err).To(MatchError(ContainSubstring("permission")))
+		Expect(IsNotFound(err)).To(BeTrue())
+	})
+})
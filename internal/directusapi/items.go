@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directusapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Role is an item of /roles
+type Role struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Icon        string `json:"icon,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Policy is an item of /policies
+type Policy struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Icon        string   `json:"icon,omitempty"`
+	Description string   `json:"description,omitempty"`
+	IPAccess    []string `json:"ip_access"`
+	EnforceTFA  bool     `json:"enforce_tfa"`
+	AdminAccess bool     `json:"admin_access"`
+	AppAccess   bool     `json:"app_access"`
+}
+
+// Permission is an item of /permissions, the filters are raw Directus filter objects
+type Permission struct {
+	ID          int             `json:"id,omitempty"`
+	Policy      string          `json:"policy"`
+	Collection  string          `json:"collection"`
+	Action      string          `json:"action"`
+	Fields      []string        `json:"fields"`
+	Permissions json.RawMessage `json:"permissions"`
+	Validation  json.RawMessage `json:"validation"`
+	Presets     json.RawMessage `json:"presets"`
+}
+
+// User is an item of /users
+type User struct {
+	ID        string  `json:"id,omitempty"`
+	Email     string  `json:"email"`
+	FirstName string  `json:"first_name"`
+	LastName  string  `json:"last_name"`
+	Role      *string `json:"role"`
+	Status    string  `json:"status,omitempty"`
+}
+
+// Flow is an item of /flows, Operation references its first operation
+type Flow struct {
+	ID             string          `json:"id,omitempty"`
+	Name           string          `json:"name"`
+	Icon           string          `json:"icon,omitempty"`
+	Color          string          `json:"color,omitempty"`
+	Description    string          `json:"description"`
+	Status         string          `json:"status"`
+	Trigger        string          `json:"trigger"`
+	Accountability *string         `json:"accountability"`
+	Options        json.RawMessage `json:"options"`
+	Operation      *string         `json:"operation"`
+}
+
+// Operation is an item of /operations, Resolve and Reject reference the
+// operations run on success and on failure
+type Operation struct {
+	ID        string          `json:"id,omitempty"`
+	Flow      string          `json:"flow"`
+	Name      string          `json:"name"`
+	Key       string          `json:"key"`
+	Type      string          `json:"type"`
+	PositionX int32           `json:"position_x"`
+	PositionY int32           `json:"position_y"`
+	Options   json.RawMessage `json:"options"`
+	Resolve   *string         `json:"resolve"`
+	Reject    *string         `json:"reject"`
+}
+
+// Translation is an item of /translations, a custom translation string
+type Translation struct {
+	ID       string `json:"id,omitempty"`
+	Key      string `json:"key"`
+	Language string `json:"language"`
+	Value    string `json:"value"`
+}
+
+// Access attaches a policy to a role or a user, an item of /access
+type Access struct {
+	ID     string  `json:"id,omitempty"`
+	Role   *string `json:"role"`
+	User   *string `json:"user"`
+	Policy string  `json:"policy"`
+}
+
+// Filter returns the query selecting all items whose field equals value
+func Filter(field, value string) url.Values {
+	return url.Values{
+		"filter[" + field + "][_eq]": {value},
+		"limit":                      {"-1"},
+	}
+}
+
+// ListItems returns the items of an endpoint such as /roles matching the query
+func ListItems[T any](ctx context.Context, c *Client, path string, query url.Values) ([]T, error) {
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	var items []T
+	if err := c.Do(ctx, http.MethodGet, path, nil, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetItem returns a single item by ID
+func GetItem[T any](ctx context.Context, c *Client, path, id string) (*T, error) {
+	item := new(T)
+	if err := c.Do(ctx, http.MethodGet, path+"/"+url.PathEscape(id), nil, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// CreateItem creates an item and returns it as stored by Directus
+func CreateItem[T any](ctx context.Context, c *Client, path string, item *T) (*T, error) {
+	created := new(T)
+	if err := c.Do(ctx, http.MethodPost, path, item, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateItem updates the fields set in item and returns the stored item
+func UpdateItem[T any](ctx context.Context, c *Client, path, id string, item any) (*T, error) {
+	updated := new(T)
+	if err := c.Do(ctx, http.MethodPatch, path+"/"+url.PathEscape(id), item, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteItem deletes an item, it is not an error if the item does not exist
+func DeleteItem(ctx context.Context, c *Client, path, id string) error {
+	err := c.Do(ctx, http.MethodDelete, path+"/"+url.PathEscape(id), nil, nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
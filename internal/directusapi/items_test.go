@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directusapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Items", func() {
+	var (
+		ctx    context.Context
+		server *httptest.Server
+		mux    *http.ServeMux
+		api    *Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+		api = NewClient(server.URL, server.Client())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should list items matching a filter", func() {
+		mux.HandleFunc("GET /roles", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Query().Get("filter[name][_eq]")).To(Equal("Editor"))
+			Expect(r.URL.Query().Get("limit")).To(Equal("-1"))
+			_, _ = w.Write([]byte(`{"data":[{"id":"role-1","name":"Editor"}]}`))
+		})
+
+		roles, err := ListItems[Role](ctx, api, "/roles", Filter("name", "Editor"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roles).To(Equal([]Role{{ID: "role-1", Name: "Editor"}}))
+	})
+
+	It("should create, update and delete items", func() {
+		mux.HandleFunc("POST /permissions", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			Expect(body).To(HaveKeyWithValue("permissions", BeNil()))
+			_, _ = w.Write([]byte(`{"data":{"id":7,"policy":"policy-1","collection":"articles","action":"read"}}`))
+		})
+		mux.HandleFunc("PATCH /permissions/7", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"id":7,"policy":"policy-1","collection":"articles","action":"read","fields":["*"]}}`))
+		})
+		mux.HandleFunc("DELETE /permissions/7", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+		mux.HandleFunc("DELETE /permissions/8", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		})
+
+		created, err := CreateItem(ctx, api, "/permissions", &Permission{Policy: "policy-1", Collection: "articles", Action: "read"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created.ID).To(Equal(7))
+
+		updated, err := UpdateItem[Permission](ctx, api, "/permissions", "7", map[string]any{"fields": []string{"*"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Fields).To(Equal([]string{"*"}))
+
+		Expect(DeleteItem(ctx, api, "/permissions", "7")).To(Succeed())
+		Expect(DeleteItem(ctx, api, "/permissions", "8")).To(Succeed())
+	})
+})
@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directusapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SchemaDiff is the difference between a snapshot and the schema of the
+// instance, as returned by /schema/diff
+type SchemaDiff struct {
+	Hash string          `json:"hash"`
+	Diff json.RawMessage `json:"diff"`
+}
+
+// schemaChanges is the part of a diff used to summarize it
+type schemaChanges struct {
+	Collections []schemaChange `json:"collections"`
+	Fields      []schemaChange `json:"fields"`
+	Relations   []schemaChange `json:"relations"`
+}
+
+type schemaChange struct {
+	Collection string `json:"collection"`
+	Field      string `json:"field"`
+	Diff       []struct {
+		Kind string `json:"kind"`
+	} `json:"diff"`
+}
+
+// SchemaDiff compares a schema snapshot with the instance. It returns nil when
+// the schema already matches. Force skips the Directus version and database
+// vendor checks.
+func (c *Client) SchemaDiff(ctx context.Context, snapshot json.RawMessage, force bool) (*SchemaDiff, error) {
+	path := "/schema/diff"
+	if force {
+		path += "?" + url.Values{"force": {"true"}}.Encode()
+	}
+
+	diff := &SchemaDiff{}
+	// Directus answers 204 without a body when there is nothing to apply
+	if err := c.Do(ctx, http.MethodPost, path, snapshot, diff); err != nil {
+		return nil, err
+	}
+	if diff.Hash == "" {
+		return nil, nil
+	}
+	return diff, nil
+}
+
+// SchemaApply applies a diff returned by SchemaDiff
+func (c *Client) SchemaApply(ctx context.Context, diff *SchemaDiff) error {
+	return c.Do(ctx, http.MethodPost, "/schema/apply", diff, nil)
+}
+
+// Changes summarizes the diff as one line per changed collection, field or
+// relation, e.g. "create field articles.title"
+func (d *SchemaDiff) Changes() ([]string, error) {
+	var changes schemaChanges
+	if err := json.Unmarshal(d.Diff, &changes); err != nil {
+		return nil, fmt.Errorf("failed to decode schema diff: %w", err)
+	}
+
+	var summary []string
+	add := func(kind string, items []schemaChange) {
+		for _, item := range items {
+			name := item.Collection
+			if item.Field != "" {
+				name += "." + item.Field
+			}
+			summary = append(summary, fmt.Sprintf("%s %s %s", changeAction(item), kind, name))
+		}
+	}
+	add("collection", changes.Collections)
+	add("field", changes.Fields)
+	add("relation", changes.Relations)
+	return summary, nil
+}
+
+// changeAction maps the deep-diff kind of a change to an action
+func changeAction(item schemaChange) string {
+	if len(item.Diff) == 1 {
+		switch item.Diff[0].Kind {
+		case "N":
+			return "create"
+		case "D":
+			return "delete"
+		}
+	}
+	return "update"
+}
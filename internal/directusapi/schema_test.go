@@ -0,0 +1,83 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directusapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schema", func() {
+	var (
+		ctx    context.Context
+		server *httptest.Server
+		mux    *http.ServeMux
+		api    *Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+		api = NewClient(server.URL, server.Client())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should return nil when the schema matches", func() {
+		mux.HandleFunc("POST /schema/diff", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		diff, err := api.SchemaDiff(ctx, json.RawMessage(`{"version":1}`), false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff).To(BeNil())
+	})
+
+	It("should summarize and apply a diff", func() {
+		mux.HandleFunc("POST /schema/diff", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Query().Get("force")).To(Equal("true"))
+			_, _ = w.Write([]byte(`{"data":{"hash":"abc","diff":{
+				"collections":[{"collection":"articles","diff":[{"kind":"N","rhs":{}}]}],
+				"fields":[{"collection":"articles","field":"title","diff":[{"kind":"E","path":["meta","note"]},{"kind":"E","path":["schema","max_length"]}]}],
+				"relations":[{"collection":"articles","field":"author","diff":[{"kind":"D","lhs":{}}]}]}}}`))
+		})
+		mux.HandleFunc("POST /schema/apply", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			Expect(body).To(HaveKeyWithValue("hash", "abc"))
+			Expect(body).To(HaveKey("diff"))
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+		diff, err := api.SchemaDiff(ctx, json.RawMessage(`{"version":1}`), true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Changes()).To(Equal([]string{
+			"create collection articles",
+			"update field articles.title",
+			"delete relation articles.author",
+		}))
+		Expect(api.SchemaApply(ctx, diff)).To(Succeed())
+	})
+})
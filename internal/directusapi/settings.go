@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directusapi
+
+import (
+	"context"
+	"net/http"
+)
+
+// Settings are the project settings of /settings. Unset fields are left
+// unchanged by UpdateSettings.
+type Settings struct {
+	ProjectName            *string `json:"project_name,omitempty"`
+	ProjectDescriptor      *string `json:"project_descriptor,omitempty"`
+	ProjectURL             *string `json:"project_url,omitempty"`
+	ProjectColor           *string `json:"project_color,omitempty"`
+	ProjectLogo            *string `json:"project_logo,omitempty"`
+	DefaultLanguage        *string `json:"default_language,omitempty"`
+	PublicRegistration     *bool   `json:"public_registration,omitempty"`
+	PublicRegistrationRole *string `json:"public_registration_role,omitempty"`
+	AuthLoginAttempts      *int32  `json:"auth_login_attempts,omitempty"`
+}
+
+// File is an item of /files
+type File struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// GetSettings returns the project settings
+func (c *Client) GetSettings(ctx context.Context) (*Settings, error) {
+	settings := &Settings{}
+	if err := c.Do(ctx, http.MethodGet, "/settings", nil, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpdateSettings updates the fields set in settings
+func (c *Client) UpdateSettings(ctx context.Context, settings *Settings) error {
+	return c.Do(ctx, http.MethodPatch, "/settings", settings, nil)
+}
+
+// ImportFile has Directus download a file from a URL and returns the stored file
+func (c *Client) ImportFile(ctx context.Context, fileURL, title string) (*File, error) {
+	body := map[string]any{
+		"url":  fileURL,
+		"data": map[string]string{"title": title},
+	}
+	file := &File{}
+	if err := c.Do(ctx, http.MethodPost, "/files/import", body, file); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directusapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Settings", func() {
+	var (
+		ctx    context.Context
+		server *httptest.Server
+		mux    *http.ServeMux
+		api    *Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+		api = NewClient(server.URL, server.Client())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should only send the settings that are set", func() {
+		mux.HandleFunc("GET /settings", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"id":1,"project_name":"Directus","project_logo":null,"auth_login_attempts":25}}`))
+		})
+		mux.HandleFunc("PATCH /settings", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			Expect(body).To(Equal(map[string]any{"project_name": "Acme", "public_registration": false}))
+			_, _ = w.Write([]byte(`{"data":{}}`))
+		})
+
+		settings, err := api.GetSettings(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(settings.ProjectName).To(HaveValue(Equal("Directus")))
+		Expect(settings.ProjectLogo).To(BeNil())
+		Expect(settings.AuthLoginAttempts).To(HaveValue(BeEquivalentTo(25)))
+
+		Expect(api.UpdateSettings(ctx, &Settings{ProjectName: ptr.To("Acme"), PublicRegistration: ptr.To(false)})).To(Succeed())
+	})
+
+	It("should import files from a URL", func() {
+		mux.HandleFunc("POST /files/import", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+			Expect(body).To(HaveKeyWithValue("url", "https://example.com/logo.png"))
+			_, _ = w.Write([]byte(`{"data":{"id":"file-1","title":"Logo"}}`))
+		})
+
+		file, err := api.ImportFile(ctx, "https://example.com/logo.png", "Logo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(file.ID).To(Equal("file-1"))
+	})
+})